@@ -17,11 +17,12 @@ type AIService struct {
 // GenerateFineTuningDataset generates a new AI Prompt Fine-Tuning Dataset.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/AI/operation/api.ai.prompts.fine-tuning.datasets.post
-func (s *AIService) GenerateFineTuningDataset(ctx context.Context, aiPromptID, userID int, req *model.FineTuningDatasetAttributes) (
+func (s *AIService) GenerateFineTuningDataset(ctx context.Context, aiPromptID, userID int, req *model.FineTuningDatasetAttributes, reqOpts ...RequestOption) (
 	*model.FineTuningDataset, *Response, error,
 ) {
+	ctx = withOperation(ctx, "AIService.GenerateFineTuningDataset")
 	res := new(model.FineTuningDatasetResponse)
-	resp, err := s.client.Post(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/datasets", aiPromptID), userID), req, res)
+	resp, err := s.client.Post(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/datasets", aiPromptID), userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -29,23 +30,35 @@ func (s *AIService) GenerateFineTuningDataset(ctx context.Context, aiPromptID, u
 // GetFineTuningDatasetGenerationStatus returns the status of the AI Prompt Fine-Tuning Dataset generation.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/AI/operation/api.users.ai.prompts.fine-tuning.datasets.get
-func (s *AIService) GetFineTuningDatasetGenerationStatus(ctx context.Context, aiPromptID int, jobIdentifier string, userID int) (
+func (s *AIService) GetFineTuningDatasetGenerationStatus(ctx context.Context, aiPromptID int, jobIdentifier string, userID int, reqOpts ...RequestOption) (
 	*model.FineTuningDataset, *Response, error,
 ) {
+	ctx = withOperation(ctx, "AIService.GetFineTuningDatasetGenerationStatus")
 	res := new(model.FineTuningDatasetResponse)
-	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/datasets/%s", aiPromptID, jobIdentifier), userID), nil, res)
+	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/datasets/%s", aiPromptID, jobIdentifier), userID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// FineTuningDatasetOperation returns an operation polling the status of the generation of the AI Prompt Fine-Tuning Dataset
+// with GetFineTuningDatasetGenerationStatus.
+func (s *AIService) FineTuningDatasetOperation(aiPromptID int, jobIdentifier string, userID int, reqOpts ...RequestOption) *Operation[model.FineTuningDataset] {
+	return newOperation(func(ctx context.Context) (*model.FineTuningDataset, *Response, error) {
+		return s.GetFineTuningDatasetGenerationStatus(ctx, aiPromptID, jobIdentifier, userID, reqOpts...)
+	}, func(v *model.FineTuningDataset) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // DownloadFineTuningDataset returns a download link for the AI Prompt Fine-Tuning Dataset.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/AI/operation/api.users.ai.prompts.fine-tuning.datasets.download.get
-func (s *AIService) DownloadFineTuningDataset(ctx context.Context, aiPromptID int, jobIdentifier string, userID int) (
+func (s *AIService) DownloadFineTuningDataset(ctx context.Context, aiPromptID int, jobIdentifier string, userID int, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "AIService.DownloadFineTuningDataset")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/datasets/%s/download", aiPromptID, jobIdentifier), userID), nil, res)
+	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/datasets/%s/download", aiPromptID, jobIdentifier), userID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -53,11 +66,12 @@ func (s *AIService) DownloadFineTuningDataset(ctx context.Context, aiPromptID in
 // ListFineTuningJobs returns a list of AI Prompt Fine-Tuning Jobs.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/AI/operation/api.ai.prompts.fine-tuning.jobs.getMany
-func (s *AIService) ListFineTuningJobs(ctx context.Context, userID int, opts *model.FineTuningJobsListOptions) (
+func (s *AIService) ListFineTuningJobs(ctx context.Context, userID int, opts *model.FineTuningJobsListOptions, reqOpts ...RequestOption) (
 	[]*model.FineTuningJob, *Response, error,
 ) {
+	ctx = withOperation(ctx, "AIService.ListFineTuningJobs")
 	res := new(model.FineTuningJobsListResponse)
-	resp, err := s.client.Get(ctx, s.getPath("prompts/fine-tuning/jobs", userID), opts, res)
+	resp, err := s.client.Get(ctx, s.getPath("prompts/fine-tuning/jobs", userID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -73,11 +87,12 @@ func (s *AIService) ListFineTuningJobs(ctx context.Context, userID int, opts *mo
 // ListFineTuningEvents returns a list of AI Prompt Fine-Tuning Events.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/AI/operation/api.ai.prompts.fine-tuning.jobs.events.getMany
-func (s *AIService) ListFineTuningEvents(ctx context.Context, aiPromptID int, jobIdentifier string, userID int) (
+func (s *AIService) ListFineTuningEvents(ctx context.Context, aiPromptID int, jobIdentifier string, userID int, reqOpts ...RequestOption) (
 	[]*model.FineTuningEvent, *Response, error,
 ) {
+	ctx = withOperation(ctx, "AIService.ListFineTuningEvents")
 	res := new(model.FineTuningEventsListResponse)
-	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/jobs/%s/events", aiPromptID, jobIdentifier), userID), nil, res)
+	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/jobs/%s/events", aiPromptID, jobIdentifier), userID), nil, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -93,11 +108,12 @@ func (s *AIService) ListFineTuningEvents(ctx context.Context, aiPromptID int, jo
 // CreateFineTuningJob creates a new AI Prompt Fine-Tuning Job.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/AI/operation/api.ai.prompts.fine-tuning.jobs.post
-func (s *AIService) CreateFineTuningJob(ctx context.Context, aiPromptID, userID int, req *model.FineTuningJobCreateRequest) (
+func (s *AIService) CreateFineTuningJob(ctx context.Context, aiPromptID, userID int, req *model.FineTuningJobCreateRequest, reqOpts ...RequestOption) (
 	*model.FineTuningJob, *Response, error,
 ) {
+	ctx = withOperation(ctx, "AIService.CreateFineTuningJob")
 	res := new(model.FineTuningJobResponse)
-	resp, err := s.client.Post(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/jobs", aiPromptID), userID), req, res)
+	resp, err := s.client.Post(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/jobs", aiPromptID), userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -105,22 +121,34 @@ func (s *AIService) CreateFineTuningJob(ctx context.Context, aiPromptID, userID
 // GetFineTuningJobStatus returns the status of the AI Prompt Fine-Tuning Job.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/AI/operation/api.users.ai.prompts.fine-tuning.jobs.get
-func (s *AIService) GetFineTuningJobStatus(ctx context.Context, aiPromptID int, jobIdentifier string, userID int) (
+func (s *AIService) GetFineTuningJobStatus(ctx context.Context, aiPromptID int, jobIdentifier string, userID int, reqOpts ...RequestOption) (
 	*model.FineTuningJob, *Response, error,
 ) {
+	ctx = withOperation(ctx, "AIService.GetFineTuningJobStatus")
 	res := new(model.FineTuningJobResponse)
-	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/jobs/%s", aiPromptID, jobIdentifier), userID), nil, res)
+	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d/fine-tuning/jobs/%s", aiPromptID, jobIdentifier), userID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// FineTuningJobOperation returns an operation polling the status of the AI Prompt Fine-Tuning Job
+// with GetFineTuningJobStatus.
+func (s *AIService) FineTuningJobOperation(aiPromptID int, jobIdentifier string, userID int, reqOpts ...RequestOption) *Operation[model.FineTuningJob] {
+	return newOperation(func(ctx context.Context) (*model.FineTuningJob, *Response, error) {
+		return s.GetFineTuningJobStatus(ctx, aiPromptID, jobIdentifier, userID, reqOpts...)
+	}, func(v *model.FineTuningJob) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // ListPrompts returns a list of AI prompts.
 // For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.ai.prompts.getMany
-func (s *AIService) ListPrompts(ctx context.Context, userID int, opt *model.AIPromtsListOptions) ([]*model.Prompt, *Response, error) {
+func (s *AIService) ListPrompts(ctx context.Context, userID int, opt *model.AIPromtsListOptions, reqOpts ...RequestOption) ([]*model.Prompt, *Response, error) {
+	ctx = withOperation(ctx, "AIService.ListPrompts")
 	res := new(model.PromptsListResponse)
-	resp, err := s.client.Get(ctx, s.getPath("prompts", userID), opt, res)
+	resp, err := s.client.Get(ctx, s.getPath("prompts", userID), opt, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -137,17 +165,19 @@ func (s *AIService) ListPrompts(ctx context.Context, userID int, opt *model.AIPr
 // For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.prompts.get
-func (s *AIService) GetPrompt(ctx context.Context, promptID, userID int) (*model.Prompt, *Response, error) {
+func (s *AIService) GetPrompt(ctx context.Context, promptID, userID int, reqOpts ...RequestOption) (*model.Prompt, *Response, error) {
+	ctx = withOperation(ctx, "AIService.GetPrompt")
 	res := new(model.PromptResponse)
-	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d", promptID), userID), nil, res)
+	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("prompts/%d", promptID), userID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.prompts.post
-func (s *AIService) AddPrompt(ctx context.Context, userID int, req *model.PromptAddRequest) (*model.Prompt, *Response, error) {
+func (s *AIService) AddPrompt(ctx context.Context, userID int, req *model.PromptAddRequest, reqOpts ...RequestOption) (*model.Prompt, *Response, error) {
+	ctx = withOperation(ctx, "AIService.AddPrompt")
 	res := new(model.PromptResponse)
-	resp, err := s.client.Post(ctx, s.getPath("prompts", userID), req, res)
+	resp, err := s.client.Post(ctx, s.getPath("prompts", userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -162,9 +192,10 @@ func (s *AIService) AddPrompt(ctx context.Context, userID int, req *model.Prompt
 //   - Value (any): new value to set.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.prompts.patch
-func (s *AIService) EditPrompt(ctx context.Context, promptID, userID int, req []*model.UpdateRequest) (*model.Prompt, *Response, error) {
+func (s *AIService) EditPrompt(ctx context.Context, promptID, userID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.Prompt, *Response, error) {
+	ctx = withOperation(ctx, "AIService.EditPrompt")
 	res := new(model.PromptResponse)
-	resp, err := s.client.Patch(ctx, s.getPath(fmt.Sprintf("prompts/%d", promptID), userID), req, res)
+	resp, err := s.client.Patch(ctx, s.getPath(fmt.Sprintf("prompts/%d", promptID), userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -173,17 +204,19 @@ func (s *AIService) EditPrompt(ctx context.Context, promptID, userID int, req []
 // For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.prompts.delete
-func (s *AIService) DeletePrompt(ctx context.Context, promptID, userID int) (*Response, error) {
-	return s.client.Delete(ctx, s.getPath(fmt.Sprintf("prompts/%d", promptID), userID), nil)
+func (s *AIService) DeletePrompt(ctx context.Context, promptID, userID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "AIService.DeletePrompt")
+	return s.client.Delete(ctx, s.getPath(fmt.Sprintf("prompts/%d", promptID), userID), nil, reqOpts...)
 }
 
 // ListProviders returns a list of AI providers.
 // For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.ai.providers.getMany
-func (s *AIService) ListProviders(ctx context.Context, userID int, opt *model.ListOptions) ([]*model.Provider, *Response, error) {
+func (s *AIService) ListProviders(ctx context.Context, userID int, opt *model.ListOptions, reqOpts ...RequestOption) ([]*model.Provider, *Response, error) {
+	ctx = withOperation(ctx, "AIService.ListProviders")
 	res := new(model.ProvidersListResponse)
-	resp, err := s.client.Get(ctx, s.getPath("providers", userID), opt, res)
+	resp, err := s.client.Get(ctx, s.getPath("providers", userID), opt, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -200,9 +233,10 @@ func (s *AIService) ListProviders(ctx context.Context, userID int, opt *model.Li
 // For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.providers.get
-func (s *AIService) GetProvider(ctx context.Context, providerID, userID int) (*model.Provider, *Response, error) {
+func (s *AIService) GetProvider(ctx context.Context, providerID, userID int, reqOpts ...RequestOption) (*model.Provider, *Response, error) {
+	ctx = withOperation(ctx, "AIService.GetProvider")
 	res := new(model.ProviderResponse)
-	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("providers/%d", providerID), userID), nil, res)
+	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("providers/%d", providerID), userID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -211,9 +245,10 @@ func (s *AIService) GetProvider(ctx context.Context, providerID, userID int) (*m
 // For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.providers.post
-func (s *AIService) AddProvider(ctx context.Context, userID int, req *model.ProviderAddRequest) (*model.Provider, *Response, error) {
+func (s *AIService) AddProvider(ctx context.Context, userID int, req *model.ProviderAddRequest, reqOpts ...RequestOption) (*model.Provider, *Response, error) {
+	ctx = withOperation(ctx, "AIService.AddProvider")
 	res := new(model.ProviderResponse)
-	resp, err := s.client.Post(ctx, s.getPath("providers", userID), req, res)
+	resp, err := s.client.Post(ctx, s.getPath("providers", userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -228,9 +263,10 @@ func (s *AIService) AddProvider(ctx context.Context, userID int, req *model.Prov
 //   - Value (any): new value to set.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.providers.patch
-func (s *AIService) EditProvider(ctx context.Context, providerID, userID int, req []*model.UpdateRequest) (*model.Provider, *Response, error) {
+func (s *AIService) EditProvider(ctx context.Context, providerID, userID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.Provider, *Response, error) {
+	ctx = withOperation(ctx, "AIService.EditProvider")
 	res := new(model.ProviderResponse)
-	resp, err := s.client.Patch(ctx, s.getPath(fmt.Sprintf("providers/%d", providerID), userID), req, res)
+	resp, err := s.client.Patch(ctx, s.getPath(fmt.Sprintf("providers/%d", providerID), userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -239,17 +275,19 @@ func (s *AIService) EditProvider(ctx context.Context, providerID, userID int, re
 // For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.providers.delete
-func (s *AIService) DeleteProvider(ctx context.Context, providerID, userID int) (*Response, error) {
-	return s.client.Delete(ctx, s.getPath(fmt.Sprintf("providers/%d", providerID), userID), nil)
+func (s *AIService) DeleteProvider(ctx context.Context, providerID, userID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "AIService.DeleteProvider")
+	return s.client.Delete(ctx, s.getPath(fmt.Sprintf("providers/%d", providerID), userID), nil, reqOpts...)
 }
 
 // ListProviderModels returns a list of AI provider models.
 // For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.ai.providers.models.getMany
-func (s *AIService) ListProviderModels(ctx context.Context, providerID, userID int) ([]*model.ProviderModel, *Response, error) {
+func (s *AIService) ListProviderModels(ctx context.Context, providerID, userID int, reqOpts ...RequestOption) ([]*model.ProviderModel, *Response, error) {
+	ctx = withOperation(ctx, "AIService.ListProviderModels")
 	res := new(model.ProviderModelsListResponse)
-	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("providers/%d/models", providerID), userID), nil, res)
+	resp, err := s.client.Get(ctx, s.getPath(fmt.Sprintf("providers/%d/models", providerID), userID), nil, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -268,11 +306,12 @@ func (s *AIService) ListProviderModels(ctx context.Context, providerID, userID i
 // Please refer to the documentation for the specific provider you use to determine the required payload format.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.ai.providers.chat.completions.post
-func (s *AIService) CreateProxyChatCompletion(ctx context.Context, providerID, userID int, req *model.CreateProxyChatCompletionRequest) (
+func (s *AIService) CreateProxyChatCompletion(ctx context.Context, providerID, userID int, req *model.CreateProxyChatCompletionRequest, reqOpts ...RequestOption) (
 	*model.ProxyChatCompletion, *Response, error,
 ) {
+	ctx = withOperation(ctx, "AIService.CreateProxyChatCompletion")
 	res := new(model.ProxyChatCompletionResponse)
-	resp, err := s.client.Post(ctx, s.getPath(fmt.Sprintf("providers/%d/chat/completions", providerID), userID), req, res)
+	resp, err := s.client.Post(ctx, s.getPath(fmt.Sprintf("providers/%d/chat/completions", providerID), userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
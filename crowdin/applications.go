@@ -20,9 +20,10 @@ type ApplicationsService struct {
 // ListInstallations returns a list of application installations.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.installations.getMany
-func (s *ApplicationsService) ListInstallations(ctx context.Context, opt *model.ListOptions) ([]*model.Installation, *Response, error) {
+func (s *ApplicationsService) ListInstallations(ctx context.Context, opt *model.ListOptions, reqOpts ...RequestOption) ([]*model.Installation, *Response, error) {
+	ctx = withOperation(ctx, "ApplicationsService.ListInstallations")
 	res := new(model.InstallationsListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/applications/installations", opt, res)
+	resp, err := s.client.Get(ctx, "/api/v2/applications/installations", opt, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -38,9 +39,10 @@ func (s *ApplicationsService) ListInstallations(ctx context.Context, opt *model.
 // GetInstallation returns information about an application installation.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.installations.get
-func (s *ApplicationsService) GetInstallation(ctx context.Context, applicationID string) (*model.Installation, *Response, error) {
+func (s *ApplicationsService) GetInstallation(ctx context.Context, applicationID string, reqOpts ...RequestOption) (*model.Installation, *Response, error) {
+	ctx = withOperation(ctx, "ApplicationsService.GetInstallation")
 	res := new(model.InstallationResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/applications/installations/%s", applicationID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/applications/installations/%s", applicationID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -48,11 +50,12 @@ func (s *ApplicationsService) GetInstallation(ctx context.Context, applicationID
 // Install installs an application.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.installations.post
-func (s *ApplicationsService) Install(ctx context.Context, req *model.InstallApplicationRequest) (
+func (s *ApplicationsService) Install(ctx context.Context, req *model.InstallApplicationRequest, reqOpts ...RequestOption) (
 	*model.Installation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ApplicationsService.Install")
 	res := new(model.InstallationResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/applications/installations", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/applications/installations", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -66,11 +69,12 @@ func (s *ApplicationsService) Install(ctx context.Context, req *model.InstallApp
 //   - value (model.InstallationReplaceValue): object with values to update.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.installations.patch
-func (s *ApplicationsService) EditInstallation(ctx context.Context, applicationID string, req []*model.UpdateRequest) (
+func (s *ApplicationsService) EditInstallation(ctx context.Context, applicationID string, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Installation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ApplicationsService.EditInstallation")
 	res := new(model.InstallationResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/applications/installations/%s", applicationID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/applications/installations/%s", applicationID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -81,21 +85,23 @@ func (s *ApplicationsService) EditInstallation(ctx context.Context, applicationI
 //	force: if true, force to delete application installation
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.installations.delete
-func (s *ApplicationsService) DeleteInstallation(ctx context.Context, applicationID string, force bool) (*Response, error) {
+func (s *ApplicationsService) DeleteInstallation(ctx context.Context, applicationID string, force bool, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ApplicationsService.DeleteInstallation")
 	path := fmt.Sprintf("/api/v2/applications/installations/%s", applicationID)
 	if force {
 		path += "?force=true"
 	}
 
-	return s.client.Delete(ctx, path, nil)
+	return s.client.Delete(ctx, path, nil, reqOpts...)
 }
 
 // GetData returns application data.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.api.get
-func (s *ApplicationsService) GetData(ctx context.Context, applicationID, path string) (any, *Response, error) {
+func (s *ApplicationsService) GetData(ctx context.Context, applicationID, path string, reqOpts ...RequestOption) (any, *Response, error) {
+	ctx = withOperation(ctx, "ApplicationsService.GetData")
 	res := new(model.ApplicationDataResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -103,11 +109,12 @@ func (s *ApplicationsService) GetData(ctx context.Context, applicationID, path s
 // AddData adds application data.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.api.post
-func (s *ApplicationsService) AddData(ctx context.Context, applicationID, path string, req map[string]any) (
+func (s *ApplicationsService) AddData(ctx context.Context, applicationID, path string, req map[string]any, reqOpts ...RequestOption) (
 	any, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ApplicationsService.AddData")
 	res := new(model.ApplicationDataResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -115,11 +122,12 @@ func (s *ApplicationsService) AddData(ctx context.Context, applicationID, path s
 // UpdateOrRestoreData updates or restores application data.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.api.put
-func (s *ApplicationsService) UpdateOrRestoreData(ctx context.Context, applicationID, path string, req map[string]any) (
+func (s *ApplicationsService) UpdateOrRestoreData(ctx context.Context, applicationID, path string, req map[string]any, reqOpts ...RequestOption) (
 	any, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ApplicationsService.UpdateOrRestoreData")
 	res := new(model.ApplicationDataResponse)
-	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), req, res)
+	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -127,11 +135,12 @@ func (s *ApplicationsService) UpdateOrRestoreData(ctx context.Context, applicati
 // EditData updates application data.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.api.patch
-func (s *ApplicationsService) EditData(ctx context.Context, applicationID, path string, req map[string]any) (
+func (s *ApplicationsService) EditData(ctx context.Context, applicationID, path string, req map[string]any, reqOpts ...RequestOption) (
 	any, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ApplicationsService.EditData")
 	res := new(model.ApplicationDataResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -139,6 +148,7 @@ func (s *ApplicationsService) EditData(ctx context.Context, applicationID, path
 // DeleteData deletes application data.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.applications.api.delete
-func (s *ApplicationsService) DeleteData(ctx context.Context, applicationID, path string) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), nil)
+func (s *ApplicationsService) DeleteData(ctx context.Context, applicationID, path string, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ApplicationsService.DeleteData")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/applications/%s/api/%s", applicationID, path), nil, reqOpts...)
 }
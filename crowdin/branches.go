@@ -16,11 +16,12 @@ type BranchesService struct {
 // List returns a list of project branches.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.getMany
-func (s *BranchesService) List(ctx context.Context, projectID int, opts *model.BranchesListOptions) (
+func (s *BranchesService) List(ctx context.Context, projectID int, opts *model.BranchesListOptions, reqOpts ...RequestOption) (
 	[]*model.Branch, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BranchesService.List")
 	res := new(model.BranchesListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches", projectID), opts, res, reqOpts...)
 
 	branches := make([]*model.Branch, 0, len(res.Data))
 	for _, b := range res.Data {
@@ -33,9 +34,10 @@ func (s *BranchesService) List(ctx context.Context, projectID int, opts *model.B
 // Get returns a single project branch.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.get
-func (s *BranchesService) Get(ctx context.Context, projectID, branchID int) (*model.Branch, *Response, error) {
+func (s *BranchesService) Get(ctx context.Context, projectID, branchID int, reqOpts ...RequestOption) (*model.Branch, *Response, error) {
+	ctx = withOperation(ctx, "BranchesService.Get")
 	res := new(model.BranchesGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -43,11 +45,12 @@ func (s *BranchesService) Get(ctx context.Context, projectID, branchID int) (*mo
 // Add creates a new project branch.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.post
-func (s *BranchesService) Add(ctx context.Context, projectID int, req *model.BranchesAddRequest) (
+func (s *BranchesService) Add(ctx context.Context, projectID int, req *model.BranchesAddRequest, reqOpts ...RequestOption) (
 	*model.Branch, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BranchesService.Add")
 	res := new(model.BranchesGetResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/branches", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/branches", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -60,11 +63,12 @@ func (s *BranchesService) Add(ctx context.Context, projectID int, req *model.Bra
 // - value: The value to be used within the operations. The value must be one of string.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.patch
-func (s *BranchesService) Edit(ctx context.Context, projectID, branchID int, req []*model.UpdateRequest) (
+func (s *BranchesService) Edit(ctx context.Context, projectID, branchID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Branch, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BranchesService.Edit")
 	res := new(model.BranchesGetResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -72,18 +76,20 @@ func (s *BranchesService) Edit(ctx context.Context, projectID, branchID int, req
 // Delete deletes a project branch.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.delete
-func (s *BranchesService) Delete(ctx context.Context, projectID, branchID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID), nil)
+func (s *BranchesService) Delete(ctx context.Context, projectID, branchID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "BranchesService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d", projectID, branchID), nil, reqOpts...)
 }
 
 // Merge merges a project branch.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.branches.merges.post
-func (s *BranchesService) Merge(ctx context.Context, projectID, branchID int, req *model.BranchesMergeRequest) (
+func (s *BranchesService) Merge(ctx context.Context, projectID, branchID int, req *model.BranchesMergeRequest, reqOpts ...RequestOption) (
 	*model.BranchMerge, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BranchesService.Merge")
 	res := new(model.BranchesMergeResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/merges", projectID, branchID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/merges", projectID, branchID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -91,24 +97,36 @@ func (s *BranchesService) Merge(ctx context.Context, projectID, branchID int, re
 // CheckMergeStatus checks the status of a branch merge.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.branches.merges.get
-func (s *BranchesService) CheckMergeStatus(ctx context.Context, projectID, branchID int, mergeID string) (
+func (s *BranchesService) CheckMergeStatus(ctx context.Context, projectID, branchID int, mergeID string, reqOpts ...RequestOption) (
 	*model.BranchMerge, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BranchesService.CheckMergeStatus")
 	res := new(model.BranchesMergeResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/merges/%s", projectID, branchID, mergeID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/merges/%s", projectID, branchID, mergeID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// MergeOperation returns an operation polling the status of the branch merge
+// with CheckMergeStatus.
+func (s *BranchesService) MergeOperation(projectID, branchID int, mergeID string, reqOpts ...RequestOption) *Operation[model.BranchMerge] {
+	return newOperation(func(ctx context.Context) (*model.BranchMerge, *Response, error) {
+		return s.CheckMergeStatus(ctx, projectID, branchID, mergeID, reqOpts...)
+	}, func(v *model.BranchMerge) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // GetMergeSummary returns a summary of a branch merge.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.branches.merges.summary.get
-func (s *BranchesService) GetMergeSummary(ctx context.Context, projectID, branchID int, mergeID string) (
+func (s *BranchesService) GetMergeSummary(ctx context.Context, projectID, branchID int, mergeID string, reqOpts ...RequestOption) (
 	*model.BranchMergeSummary, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BranchesService.GetMergeSummary")
 	path := fmt.Sprintf("/api/v2/projects/%d/branches/%d/merges/%s/summary", projectID, branchID, mergeID)
 	res := new(model.BranchesMergeSummaryResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -117,12 +135,13 @@ func (s *BranchesService) GetMergeSummary(ctx context.Context, projectID, branch
 // Note: Only the main branch (oldest branch) can be cloned.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.branches.clones.post
-func (s *BranchesService) Clone(ctx context.Context, projectID, branchID int, req *model.BranchesCloneRequest) (
+func (s *BranchesService) Clone(ctx context.Context, projectID, branchID int, req *model.BranchesCloneRequest, reqOpts ...RequestOption) (
 	*model.BranchMerge, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BranchesService.Clone")
 	path := fmt.Sprintf("/api/v2/projects/%d/branches/%d/clones", projectID, branchID)
 	res := new(model.BranchesMergeResponse)
-	resp, err := s.client.Post(ctx, path, req, res)
+	resp, err := s.client.Post(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -130,10 +149,11 @@ func (s *BranchesService) Clone(ctx context.Context, projectID, branchID int, re
 // GetClone returns a cloned project branch.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.branches.clones.branch.get
-func (s *BranchesService) GetClone(ctx context.Context, projectID, branchID int, cloneID string) (*model.Branch, *Response, error) {
+func (s *BranchesService) GetClone(ctx context.Context, projectID, branchID int, cloneID string, reqOpts ...RequestOption) (*model.Branch, *Response, error) {
+	ctx = withOperation(ctx, "BranchesService.GetClone")
 	path := fmt.Sprintf("/api/v2/projects/%d/branches/%d/clones/%s/branch", projectID, branchID, cloneID)
 	res := new(model.BranchesGetResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -141,12 +161,23 @@ func (s *BranchesService) GetClone(ctx context.Context, projectID, branchID int,
 // CheckCloneStatus checks the status of a branch clone.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.branches.clones.get
-func (s *BranchesService) CheckCloneStatus(ctx context.Context, projectID, branchID int, cloneID string) (
+func (s *BranchesService) CheckCloneStatus(ctx context.Context, projectID, branchID int, cloneID string, reqOpts ...RequestOption) (
 	*model.BranchMerge, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BranchesService.CheckCloneStatus")
 	path := fmt.Sprintf("/api/v2/projects/%d/branches/%d/clones/%s", projectID, branchID, cloneID)
 	res := new(model.BranchesMergeResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
+
+// CloneOperation returns an operation polling the status of the branch clone
+// with CheckCloneStatus.
+func (s *BranchesService) CloneOperation(projectID, branchID int, cloneID string, reqOpts ...RequestOption) *Operation[model.BranchMerge] {
+	return newOperation(func(ctx context.Context) (*model.BranchMerge, *Response, error) {
+		return s.CheckCloneStatus(ctx, projectID, branchID, cloneID, reqOpts...)
+	}, func(v *model.BranchMerge) (string, int) {
+		return v.Status, v.Progress
+	})
+}
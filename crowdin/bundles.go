@@ -15,11 +15,12 @@ type BundlesService struct {
 // List returns a list of bundles.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.getMany
-func (s *BundlesService) List(ctx context.Context, projectID int, opts *model.ListOptions) (
+func (s *BundlesService) List(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.Bundle, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BundlesService.List")
 	res := new(model.BundlesListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -35,9 +36,10 @@ func (s *BundlesService) List(ctx context.Context, projectID int, opts *model.Li
 // Get returns the bundle by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.get
-func (s *BundlesService) Get(ctx context.Context, projectID, bundleID int) (*model.Bundle, *Response, error) {
+func (s *BundlesService) Get(ctx context.Context, projectID, bundleID int, reqOpts ...RequestOption) (*model.Bundle, *Response, error) {
+	ctx = withOperation(ctx, "BundlesService.Get")
 	res := new(model.BundleResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -45,11 +47,12 @@ func (s *BundlesService) Get(ctx context.Context, projectID, bundleID int) (*mod
 // Add creates a new bundle.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.post
-func (s *BundlesService) Add(ctx context.Context, projectID int, req *model.BundleAddRequest) (
+func (s *BundlesService) Add(ctx context.Context, projectID int, req *model.BundleAddRequest, reqOpts ...RequestOption) (
 	*model.Bundle, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BundlesService.Add")
 	res := new(model.BundleResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -65,11 +68,12 @@ func (s *BundlesService) Add(ctx context.Context, projectID int, req *model.Bund
 //     The value must be string or integer.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.patch
-func (s *BundlesService) Edit(ctx context.Context, projectID, bundleID int, req []*model.UpdateRequest) (
+func (s *BundlesService) Edit(ctx context.Context, projectID, bundleID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Bundle, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BundlesService.Edit")
 	res := new(model.BundleResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -77,19 +81,21 @@ func (s *BundlesService) Edit(ctx context.Context, projectID, bundleID int, req
 // Delete removes the bundle.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.delete
-func (s *BundlesService) Delete(ctx context.Context, projectID, bundleID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID), nil)
+func (s *BundlesService) Delete(ctx context.Context, projectID, bundleID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "BundlesService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d", projectID, bundleID), nil, reqOpts...)
 }
 
 // Download returns a download link for the bundle.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.exports.download.get
-func (s *BundlesService) Download(ctx context.Context, projectID, bundleID int, exportID string) (
+func (s *BundlesService) Download(ctx context.Context, projectID, bundleID int, exportID string, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BundlesService.Download")
 	res := new(model.DownloadLinkResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/bundles/%d/exports/%s/download", projectID, bundleID, exportID)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -97,11 +103,12 @@ func (s *BundlesService) Download(ctx context.Context, projectID, bundleID int,
 // Export starts the export process for the bundle.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.exports.post
-func (s *BundlesService) Export(ctx context.Context, projectID, bundleID int) (
+func (s *BundlesService) Export(ctx context.Context, projectID, bundleID int, reqOpts ...RequestOption) (
 	*model.BundleExport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BundlesService.Export")
 	res := new(model.BundleExportResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/exports", projectID, bundleID), "", res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/exports", projectID, bundleID), "", res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -109,24 +116,36 @@ func (s *BundlesService) Export(ctx context.Context, projectID, bundleID int) (
 // CheckExportStatus returns the status of the bundle export.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.exports.get
-func (s *BundlesService) CheckExportStatus(ctx context.Context, projectID, bundleID int, exportID string) (
+func (s *BundlesService) CheckExportStatus(ctx context.Context, projectID, bundleID int, exportID string, reqOpts ...RequestOption) (
 	*model.BundleExport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BundlesService.CheckExportStatus")
 	res := new(model.BundleExportResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/bundles/%d/exports/%s", projectID, bundleID, exportID)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// ExportOperation returns an operation polling the status of the bundle export
+// with CheckExportStatus.
+func (s *BundlesService) ExportOperation(projectID, bundleID int, exportID string, reqOpts ...RequestOption) *Operation[model.BundleExport] {
+	return newOperation(func(ctx context.Context) (*model.BundleExport, *Response, error) {
+		return s.CheckExportStatus(ctx, projectID, bundleID, exportID, reqOpts...)
+	}, func(v *model.BundleExport) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // ListFiles returns a list of files included in the bundle.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.bundles.files.getMany
-func (s *BundlesService) ListFiles(ctx context.Context, projectID, bundleID int, opts *model.ListOptions) (
+func (s *BundlesService) ListFiles(ctx context.Context, projectID, bundleID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.File, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BundlesService.ListFiles")
 	res := new(model.FileListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/files", projectID, bundleID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/files", projectID, bundleID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -142,11 +161,12 @@ func (s *BundlesService) ListFiles(ctx context.Context, projectID, bundleID int,
 // ListBranches returns a list of branches included in the bundle.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.bundles.branches.getMany
-func (s *BundlesService) ListBranches(ctx context.Context, projectID, bundleID int, opts *model.ListOptions) (
+func (s *BundlesService) ListBranches(ctx context.Context, projectID, bundleID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.Branch, *Response, error,
 ) {
+	ctx = withOperation(ctx, "BundlesService.ListBranches")
 	res := new(model.BranchesListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/branches", projectID, bundleID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/bundles/%d/branches", projectID, bundleID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
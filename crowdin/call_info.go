@@ -0,0 +1,38 @@
+package crowdin
+
+import (
+	"context"
+	"time"
+)
+
+// CallInfo describes an API call. It is available to middlewares
+// through CallInfoFromContext and is updated while the call is
+// in progress.
+type CallInfo struct {
+	// Operation is the name of the method that issued the call,
+	// e.g. "TranslationsService.BuildProjectTranslation" or "Client.Get".
+	Operation string
+	// Attempts is the number of attempts made to send the request.
+	// It is greater than 1 if the request has been retried.
+	Attempts int
+	// RateLimitWait is the total time spent waiting for
+	// the client-side rate limiter.
+	RateLimitWait time.Duration
+}
+
+type callInfoKey struct{}
+
+// CallInfoFromContext returns the CallInfo of the API call
+// the context belongs to.
+func CallInfoFromContext(ctx context.Context) (*CallInfo, bool) {
+	info, ok := ctx.Value(callInfoKey{}).(*CallInfo)
+	return info, ok
+}
+
+type operationKey struct{}
+
+// withOperation returns a copy of ctx naming the operation
+// of the API calls made with it, e.g. "StorageService.Get".
+func withOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, name)
+}
@@ -0,0 +1,155 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func captureCallInfo(info **CallInfo) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(r *http.Request, v any) (*Response, error) {
+			*info, _ = CallInfoFromContext(r.Context())
+			return next(r, v)
+		}
+	}
+}
+
+func TestCallInfo_operation(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	var info *CallInfo
+	require.NoError(t, WithMiddleware(captureCallInfo(&info))(client))
+
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, "StorageService.Get", info.Operation)
+	assert.Equal(t, 1, info.Attempts)
+
+	_, err = client.Get(context.Background(), "/api/v2/storages/1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Client.Get", info.Operation)
+
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprint(w, `{"data": {"id": 1}}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": []}`)
+	})
+	fsys := fstest.MapFS{"a.json": {Data: []byte(`{}`)}}
+	_, _, err = client.Storages.AddFS(context.Background(), fsys, "a.json", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "StorageService.AddFS", info.Operation)
+
+	name := filepath.Join(t.TempDir(), "a.json")
+	require.NoError(t, os.WriteFile(name, []byte(`{}`), 0o600))
+	file, err := os.Open(name)
+	require.NoError(t, err)
+	defer file.Close()
+	_, _, err = client.Storages.Add(context.Background(), file)
+	require.NoError(t, err)
+	assert.Equal(t, "StorageService.Add", info.Operation)
+
+	_, _, err = client.Storages.AddReader(context.Background(), "a.json", strings.NewReader(`{}`), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "StorageService.AddReader", info.Operation)
+
+	for _, err := range client.Storages.ListAll(context.Background(), nil) {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "StorageService.List", info.Operation)
+}
+
+func TestCallInfo_attemptsAndRateLimitWait(t *testing.T) {
+	client, mux, teardown := setupRetryClient(t)
+	defer teardown()
+
+	var info *CallInfo
+	require.NoError(t, WithMiddleware(captureCallInfo(&info))(client))
+	require.NoError(t, WithRateLimit(RateLimit{RequestsPerSecond: 50})(client))
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := client.Get(context.Background(), "/get", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, info.Attempts)
+	assert.Greater(t, info.RateLimitWait, time.Duration(0))
+}
+
+func TestCallInfoFromContext_missing(t *testing.T) {
+	info, ok := CallInfoFromContext(context.Background())
+	assert.False(t, ok)
+	assert.Nil(t, info)
+}
+
+// TestServiceOperationNames checks that the service methods
+// name the operation of their API calls after themselves.
+func TestServiceOperationNames(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+
+	fset := token.NewFileSet()
+	methods := 0
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") || name == "list_all_gen.go" {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		require.NoError(t, err)
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() || len(fn.Type.Params.List) == 0 {
+				continue
+			}
+			recv, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			service, ok := recv.X.(*ast.Ident)
+			if !ok || !strings.HasSuffix(service.Name, "Service") {
+				continue
+			}
+			if ctx := fn.Type.Params.List[0]; len(ctx.Names) == 0 || ctx.Names[0].Name != "ctx" {
+				continue
+			}
+
+			methods++
+			want := fmt.Sprintf("ctx = withOperation(ctx, %q)", service.Name+"."+fn.Name.Name)
+			var got strings.Builder
+			if len(fn.Body.List) > 0 {
+				require.NoError(t, printer.Fprint(&got, fset, fn.Body.List[0]))
+			}
+			assert.Equal(t, want, got.String(), fset.Position(fn.Pos()))
+		}
+	}
+	assert.Greater(t, methods, 300)
+}
@@ -0,0 +1,344 @@
+// Package config loads the crowdin.yml configuration file of the Crowdin CLI.
+//
+// The configuration provides the credentials of the project and the mapping
+// of the local source files to their translations:
+//
+//	project_id_env: CROWDIN_PROJECT_ID
+//	api_token_env: CROWDIN_PERSONAL_TOKEN
+//	base_path: .
+//	preserve_hierarchy: true
+//	files:
+//	  - source: /locales/en/**/*.json
+//	    translation: /locales/%two_letters_code%/**/%original_file_name%
+//	    update_option: update_as_unapproved
+//	    languages_mapping:
+//	      two_letters_code:
+//	        uk: ua
+//
+// Load parses and validates the file, and resolves the credentials from the
+// environment. The configuration creates a ready *crowdin.Client and expands
+// the source patterns into the file mappings used with SourceFilesService
+// and TranslationsService:
+//
+//	cfg, err := config.Load("crowdin.yml")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client, err := cfg.NewClient()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	mappings, err := cfg.Mappings()
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/exportpattern"
+)
+
+// Config is the configuration of a Crowdin project.
+type Config struct {
+	// ProjectID is the identifier of the project.
+	ProjectID int
+	// APIToken is the personal access token.
+	APIToken string
+	// BaseURL is the base URL of the API, e.g. "https://acme.api.crowdin.com"
+	// for Crowdin Enterprise. Empty for crowdin.com.
+	BaseURL string
+	// BasePath is the directory the source and translation patterns are
+	// relative to. It is resolved relative to the directory of the file.
+	BasePath string
+	// Branch is the name of the branch the files are synced to.
+	Branch string
+	// PreserveHierarchy defines whether the directories of the source files
+	// are kept in the project. Otherwise, the common directories are removed.
+	PreserveHierarchy bool
+	// Files are the file groups of the project.
+	Files []*File
+}
+
+// File is a group of source files and the pattern of their translations.
+type File struct {
+	// Source is the pattern of the source files relative to the base path,
+	// e.g. "/locales/en/**/*.json".
+	Source string
+	// Translation is the pattern of the translations relative to the base
+	// path, e.g. "/locales/%two_letters_code%/%original_file_name%".
+	Translation string
+	// Dest is the pattern of the path of the files in the project,
+	// e.g. "/app/%original_file_name%".
+	Dest string
+	// Ignore lists the patterns of the source files to ignore.
+	// The placeholders of the patterns match any name.
+	Ignore []string
+	// Type is the type of the files, e.g. "json". Defaults to auto.
+	Type string
+	// UpdateOption is the update option of the API, e.g. "keep_translations".
+	// The values of the CLI, "update_as_unapproved" and
+	// "update_without_changes", are converted.
+	UpdateOption string
+	// LanguagesMapping overrides the placeholders of the languages, e.g.
+	// {"two_letters_code": {"uk": "ua"}} maps the "%two_letters_code%"
+	// placeholder of the "uk" language to "ua".
+	LanguagesMapping map[string]map[string]string
+	// ExcludedTargetLanguages lists the languages the files are not translated to.
+	ExcludedTargetLanguages []string
+	// Labels lists the titles of the labels attached to the strings.
+	Labels []string
+
+	TranslateContent        *bool
+	TranslateAttributes     *bool
+	ContentSegmentation     *bool
+	TranslatableElements    []string
+	FirstLineContainsHeader *bool
+	Scheme                  map[string]int
+	EscapeQuotes            *int
+	EscapeSpecialCharacters *int
+	ExportQuotes            string
+	TranslationReplace      map[string]string
+
+	SkipUntranslatedStrings *bool
+	SkipUntranslatedFiles   *bool
+	ExportOnlyApproved      *bool
+}
+
+// rawConfig is the YAML representation of the configuration.
+type rawConfig struct {
+	ProjectID         any       `yaml:"project_id"`
+	ProjectIDEnv      string    `yaml:"project_id_env"`
+	APIToken          string    `yaml:"api_token"`
+	APITokenEnv       string    `yaml:"api_token_env"`
+	BaseURL           string    `yaml:"base_url"`
+	BaseURLEnv        string    `yaml:"base_url_env"`
+	BasePath          string    `yaml:"base_path"`
+	BasePathEnv       string    `yaml:"base_path_env"`
+	Branch            string    `yaml:"branch"`
+	PreserveHierarchy bool      `yaml:"preserve_hierarchy"`
+	Files             []rawFile `yaml:"files"`
+}
+
+type rawFile struct {
+	Source                  string                       `yaml:"source"`
+	Translation             string                       `yaml:"translation"`
+	Dest                    string                       `yaml:"dest"`
+	Ignore                  []string                     `yaml:"ignore"`
+	Type                    string                       `yaml:"type"`
+	UpdateOption            string                       `yaml:"update_option"`
+	LanguagesMapping        map[string]map[string]string `yaml:"languages_mapping"`
+	ExcludedTargetLanguages []string                     `yaml:"excluded_target_languages"`
+	Labels                  []string                     `yaml:"labels"`
+	TranslateContent        *int                         `yaml:"translate_content"`
+	TranslateAttributes     *int                         `yaml:"translate_attributes"`
+	ContentSegmentation     *int                         `yaml:"content_segmentation"`
+	TranslatableElements    []string                     `yaml:"translatable_elements"`
+	FirstLineContainsHeader *bool                        `yaml:"first_line_contains_header"`
+	Scheme                  string                       `yaml:"scheme"`
+	EscapeQuotes            *int                         `yaml:"escape_quotes"`
+	EscapeSpecialCharacters *int                         `yaml:"escape_special_characters"`
+	ExportQuotes            string                       `yaml:"export_quotes"`
+	TranslationReplace      map[string]string            `yaml:"translation_replace"`
+	SkipUntranslatedStrings *bool                        `yaml:"skip_untranslated_strings"`
+	SkipUntranslatedFiles   *bool                        `yaml:"skip_untranslated_files"`
+	ExportOnlyApproved      *bool                        `yaml:"export_only_approved"`
+}
+
+// Load reads the configuration file, resolves the credentials from the
+// environment and validates the configuration.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // the path of the configuration is given by the caller
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data, filepath.Dir(path))
+}
+
+// Parse parses the configuration, resolves the credentials from the
+// environment and validates the configuration. The base path is resolved
+// relative to dir.
+func Parse(data []byte, dir string) (*Config, error) {
+	var raw rawConfig
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	cfg := &Config{
+		APIToken:          fromEnv(raw.APIToken, raw.APITokenEnv),
+		BaseURL:           fromEnv(raw.BaseURL, raw.BaseURLEnv),
+		BasePath:          fromEnv(raw.BasePath, raw.BasePathEnv),
+		Branch:            raw.Branch,
+		PreserveHierarchy: raw.PreserveHierarchy,
+	}
+
+	var errs []error
+	projectID := fromEnv(fmt.Sprint(valueOr(raw.ProjectID, "")), raw.ProjectIDEnv)
+	if projectID != "" {
+		id, err := strconv.Atoi(projectID)
+		if err != nil || id <= 0 {
+			errs = append(errs, fmt.Errorf("config: invalid project_id %q", projectID))
+		}
+		cfg.ProjectID = id
+	}
+
+	if !filepath.IsAbs(cfg.BasePath) {
+		cfg.BasePath = filepath.Join(dir, cfg.BasePath)
+	}
+
+	for i, rf := range raw.Files {
+		f, err := rf.file()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("config: files[%d]: %w", i, err))
+		}
+		cfg.Files = append(cfg.Files, f)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// updateOption converts the update option of the CLI to the one of the API.
+func updateOption(option string) (string, bool) {
+	switch option {
+	case "update_as_unapproved":
+		return "keep_translations", true
+	case "update_without_changes":
+		return "keep_translations_and_approvals", true
+	case "", "clear_translations_and_approvals", "keep_translations", "keep_translations_and_approvals":
+		return option, true
+	default:
+		return "", false
+	}
+}
+
+func (rf *rawFile) file() (*File, error) {
+	f := &File{
+		Source:                  rf.Source,
+		Translation:             rf.Translation,
+		Dest:                    rf.Dest,
+		Ignore:                  rf.Ignore,
+		Type:                    rf.Type,
+		LanguagesMapping:        rf.LanguagesMapping,
+		ExcludedTargetLanguages: rf.ExcludedTargetLanguages,
+		Labels:                  rf.Labels,
+		TranslateContent:        intBool(rf.TranslateContent),
+		TranslateAttributes:     intBool(rf.TranslateAttributes),
+		ContentSegmentation:     intBool(rf.ContentSegmentation),
+		TranslatableElements:    rf.TranslatableElements,
+		FirstLineContainsHeader: rf.FirstLineContainsHeader,
+		EscapeQuotes:            rf.EscapeQuotes,
+		EscapeSpecialCharacters: rf.EscapeSpecialCharacters,
+		ExportQuotes:            rf.ExportQuotes,
+		TranslationReplace:      rf.TranslationReplace,
+		SkipUntranslatedStrings: rf.SkipUntranslatedStrings,
+		SkipUntranslatedFiles:   rf.SkipUntranslatedFiles,
+		ExportOnlyApproved:      rf.ExportOnlyApproved,
+	}
+
+	option, ok := updateOption(rf.UpdateOption)
+	if !ok {
+		return f, fmt.Errorf("invalid update_option %q", rf.UpdateOption)
+	}
+	f.UpdateOption = option
+
+	// The scheme of the CSV files, e.g. "identifier,source_phrase,translation".
+	if rf.Scheme != "" {
+		f.Scheme = make(map[string]int)
+		for i, column := range strings.Split(rf.Scheme, ",") {
+			if column = strings.TrimSpace(column); column != "" && column != "none" {
+				f.Scheme[column] = i
+			}
+		}
+	}
+	return f, nil
+}
+
+// Validate checks that the configuration has the credentials
+// and that the file groups are valid.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.ProjectID <= 0 {
+		errs = append(errs, errors.New("config: project_id is required"))
+	}
+	if c.APIToken == "" {
+		errs = append(errs, errors.New("config: api_token is required"))
+	}
+	if len(c.Files) == 0 {
+		errs = append(errs, errors.New("config: files are required"))
+	}
+
+	for i, f := range c.Files {
+		if f.Source == "" {
+			errs = append(errs, fmt.Errorf("config: files[%d]: source is required", i))
+		}
+		if f.Translation == "" {
+			errs = append(errs, fmt.Errorf("config: files[%d]: translation is required", i))
+		} else if !exportpattern.HasLanguagePlaceholder(f.Translation) {
+			errs = append(errs, fmt.Errorf("config: files[%d]: translation must contain a language placeholder, e.g. %%two_letters_code%%", i))
+		}
+		if strings.Contains(f.Source, "**") && !strings.Contains(f.Translation, "**") && !strings.Contains(f.Translation, "%original_path%") {
+			errs = append(errs, fmt.Errorf("config: files[%d]: translation must contain ** when source does", i))
+		}
+		if f.EscapeQuotes != nil && (*f.EscapeQuotes < 0 || *f.EscapeQuotes > 3) {
+			errs = append(errs, fmt.Errorf("config: files[%d]: escape_quotes must be from 0 to 3", i))
+		}
+		if f.EscapeSpecialCharacters != nil && (*f.EscapeSpecialCharacters < 0 || *f.EscapeSpecialCharacters > 1) {
+			errs = append(errs, fmt.Errorf("config: files[%d]: escape_special_characters must be 0 or 1", i))
+		}
+		if f.ExportQuotes != "" && !slices.Contains([]string{"single", "double"}, f.ExportQuotes) {
+			errs = append(errs, fmt.Errorf("config: files[%d]: export_quotes must be single or double", i))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewClient returns a client authorized with the API token
+// of the configuration. The options are applied after the
+// ones of the configuration.
+func (c *Config) NewClient(opts ...crowdin.ClientOption) (*crowdin.Client, error) {
+	if c.BaseURL != "" {
+		opts = append([]crowdin.ClientOption{crowdin.WithBaseURL(c.BaseURL)}, opts...)
+	}
+	return crowdin.NewClient(c.APIToken, opts...)
+}
+
+// fromEnv returns the value of the environment variable if the
+// name is set, otherwise the value of the configuration.
+func fromEnv(value, name string) string {
+	if name == "" {
+		return value
+	}
+	return os.Getenv(name)
+}
+
+func valueOr(v, def any) any {
+	if v == nil {
+		return def
+	}
+	return v
+}
+
+// intBool converts the 0/1 options of the CLI.
+func intBool(v *int) *bool {
+	if v == nil {
+		return nil
+	}
+	b := *v != 0
+	return &b
+}
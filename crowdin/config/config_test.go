@@ -0,0 +1,263 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/exportpattern"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o600))
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Setenv("CROWDIN_PROJECT_ID", "42")
+	t.Setenv("CROWDIN_TOKEN", "secret")
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"crowdin.yml": `
+project_id_env: CROWDIN_PROJECT_ID
+api_token_env: CROWDIN_TOKEN
+base_url: https://acme.api.crowdin.com
+base_path: src
+branch: main
+preserve_hierarchy: true
+files:
+  - source: /locales/en/**/*.json
+    translation: /locales/%two_letters_code%/**/%original_file_name%
+    update_option: update_as_unapproved
+    ignore: [/locales/en/**/draft.json]
+    languages_mapping:
+      two_letters_code:
+        uk: ua
+  - source: /data/*.csv
+    translation: /data/%locale%/%original_file_name%
+    scheme: "identifier,source_phrase,none,translation"
+    first_line_contains_header: true
+    content_segmentation: 0
+    escape_quotes: 1
+`})
+
+	cfg, err := Load(filepath.Join(dir, "crowdin.yml"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 42, cfg.ProjectID)
+	assert.Equal(t, "secret", cfg.APIToken)
+	assert.Equal(t, "https://acme.api.crowdin.com", cfg.BaseURL)
+	assert.Equal(t, filepath.Join(dir, "src"), cfg.BasePath)
+	assert.Equal(t, "main", cfg.Branch)
+	assert.True(t, cfg.PreserveHierarchy)
+
+	require.Len(t, cfg.Files, 2)
+	assert.Equal(t, "keep_translations", cfg.Files[0].UpdateOption)
+	assert.Equal(t, map[string]map[string]string{"two_letters_code": {"uk": "ua"}}, cfg.Files[0].LanguagesMapping)
+	assert.Equal(t, map[string]int{"identifier": 0, "source_phrase": 1, "translation": 3}, cfg.Files[1].Scheme)
+	assert.Equal(t, crowdin.ToPtr(false), cfg.Files[1].ContentSegmentation)
+	assert.Equal(t, crowdin.ToPtr(1), cfg.Files[1].EscapeQuotes)
+}
+
+func TestParse_projectID(t *testing.T) {
+	cfg, err := Parse([]byte(`
+project_id: "7"
+api_token: token
+files:
+  - source: /*.json
+    translation: /%locale%/%original_file_name%
+`), "/app")
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, cfg.ProjectID)
+	assert.Equal(t, filepath.FromSlash("/app"), cfg.BasePath)
+}
+
+func TestParse_invalid(t *testing.T) {
+	t.Setenv("CROWDIN_TOKEN", "")
+
+	_, err := Parse([]byte(`
+project_id: abc
+api_token_env: CROWDIN_TOKEN
+files:
+  - source: /src/**/*.json
+    translation: /%original_file_name%
+    update_option: overwrite
+    export_quotes: backtick
+`), ".")
+	require.Error(t, err)
+	assert.EqualError(t, err, `config: invalid project_id "abc"
+config: files[0]: invalid update_option "overwrite"`)
+
+	_, err = Parse([]byte(`
+files:
+  - source: /src/**/*.json
+    translation: /%original_file_name%
+    export_quotes: backtick
+`), ".")
+	assert.EqualError(t, err, `config: project_id is required
+config: api_token is required
+config: files[0]: translation must contain a language placeholder, e.g. %two_letters_code%
+config: files[0]: translation must contain ** when source does
+config: files[0]: export_quotes must be single or double`)
+
+	_, err = Parse([]byte("files: {"), ".")
+	assert.ErrorContains(t, err, "config: yaml:")
+}
+
+func TestConfig_NewClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/projects/7", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"data": {"id": 7}}`)
+	}))
+	defer server.Close()
+
+	cfg := &Config{ProjectID: 7, APIToken: "token", BaseURL: server.URL}
+	client, err := cfg.NewClient()
+	require.NoError(t, err)
+
+	project, _, err := client.Projects.Get(context.Background(), cfg.ProjectID)
+	require.NoError(t, err)
+	assert.Equal(t, 7, project.ID)
+}
+
+func TestConfig_Mappings(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"locales/en/app/menu.json":     "{}",
+		"locales/en/app/draft.json":    "{}",
+		"locales/en/strings.json":      "{}",
+		"locales/en/app/readme.md":     "",
+		"locales/fr/strings.json":      "{}",
+		"resources/values/strings.xml": "<resources/>",
+	})
+
+	cfg := &Config{
+		BasePath: dir,
+		Files: []*File{
+			{
+				Source:           "/locales/en/**/*.json",
+				Translation:      "/locales/%two_letters_code%/**/%original_file_name%",
+				Ignore:           []string{"/locales/%two_letters_code%/**/draft.json"},
+				UpdateOption:     "keep_translations",
+				LanguagesMapping: map[string]map[string]string{"two_letters_code": {"uk": "ua"}},
+				ExportQuotes:     "single",
+			},
+			{
+				Source:      "/resources/values/*.xml",
+				Translation: "/resources/values-%android_code%/%original_file_name%",
+				Dest:        "/android/%file_name%.%file_extension%",
+			},
+		},
+	}
+
+	mappings, err := cfg.Mappings()
+	require.NoError(t, err)
+	require.Len(t, mappings, 3)
+
+	assert.Equal(t, "android/strings.xml", mappings[0].Path)
+	assert.Equal(t, "app/menu.json", mappings[1].Path)
+	assert.Equal(t, "locales/en/app/menu.json", mappings[1].Source)
+	assert.Equal(t, filepath.Join(dir, "locales", "en", "app", "menu.json"), mappings[1].LocalPath())
+	assert.Equal(t, "/locales/%two_letters_code%/app/%original_file_name%", mappings[1].ExportPattern())
+	assert.Equal(t, "strings.json", mappings[2].Path)
+	assert.Equal(t, "/locales/%two_letters_code%/%original_file_name%", mappings[2].ExportPattern())
+
+	uk := &model.Language{ID: "uk", TwoLettersCode: "uk", AndroidCode: "uk-rUA"}
+	p, err := mappings[0].TranslationPath(uk)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "resources", "values-uk-rUA", "strings.xml"), p)
+	p, err = mappings[1].TranslationPath(uk)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "locales", "ua", "app", "menu.json"), p)
+
+	_, err = mappings[0].TranslationPath(&model.Language{ID: "fr"})
+	require.ErrorIs(t, err, exportpattern.ErrMissingCode)
+	assert.Equal(t, &model.JavaScriptFileExportOptions{
+		ExportPattern: "/locales/%two_letters_code%/app/%original_file_name%",
+		ExportQuotes:  "single",
+	}, mappings[1].AddFileRequest().ExportOptions)
+
+	cfg.PreserveHierarchy = true
+	mappings, err = cfg.Mappings()
+	require.NoError(t, err)
+	assert.Equal(t, "locales/en/app/menu.json", mappings[1].Path)
+	assert.Equal(t, "locales/en/strings.json", mappings[2].Path)
+}
+
+func TestMapping_requests(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"data/terms.csv": ""})
+
+	cfg := &Config{BasePath: dir, PreserveHierarchy: true, Files: []*File{{
+		Source:                  "/data/*.csv",
+		Translation:             "/data/%locale%/%original_file_name%",
+		Type:                    "csv",
+		UpdateOption:            "keep_translations",
+		FirstLineContainsHeader: crowdin.ToPtr(true),
+		Scheme:                  map[string]int{"identifier": 0, "source_phrase": 1},
+		ExcludedTargetLanguages: []string{"de"},
+	}}}
+
+	mappings, err := cfg.Mappings()
+	require.NoError(t, err)
+	require.Len(t, mappings, 1)
+	m := mappings[0]
+
+	assert.Equal(t, &model.FileAddRequest{
+		Name: "terms.csv",
+		Type: "csv",
+		ImportOptions: &model.SpreadsheetFileImportOptions{
+			FirstLineContainsHeader: crowdin.ToPtr(true),
+			Scheme:                  map[string]int{"identifier": 0, "source_phrase": 1},
+		},
+		ExportOptions:           &model.GeneralFileExportOptions{ExportPattern: "/data/%locale%/%original_file_name%"},
+		ExcludedTargetLanguages: []string{"de"},
+	}, m.AddFileRequest())
+
+	req := m.UpdateFileRequest()
+	assert.Equal(t, "keep_translations", req.UpdateOption)
+	assert.Equal(t, &model.GeneralFileExportOptions{ExportPattern: "/data/%locale%/%original_file_name%"}, req.ExportOptions)
+
+	assert.Equal(t, &model.UploadTranslationsRequest{FileID: 3}, m.UploadTranslationsRequest(3))
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		name       string
+		doubleStar string
+		ok         bool
+	}{
+		{"/*.json", "a.json", "", true},
+		{"/*.json", "dir/a.json", "", false},
+		{"/src/**/*.json", "src/a.json", "", true},
+		{"/src/**/*.json", "src/a/b/c.json", "a/b", true},
+		{"/src/**/*.json", "lib/a.json", "", false},
+		{"/**/values/*.xml", "app/res/values/strings.xml", "app/res", true},
+		{"/src/[ab].json", "src/c.json", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.name, func(t *testing.T) {
+			doubleStar, ok := match(tt.pattern, tt.name)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.doubleStar, doubleStar)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// Mapping maps a local source file to its path in the project and to the
+// paths of its translations.
+type Mapping struct {
+	// File is the file group of the source file.
+	File *File
+	// Source is the slash-separated path of the source file
+	// relative to the base path, e.g. "locales/en/app/menu.json".
+	Source string
+	// Path is the slash-separated path of the file in the project,
+	// e.g. "locales/en/app/menu.json" or "app/menu.json".
+	Path string
+
+	basePath string
+	// doubleStar is the part of the source path matched by "**".
+	doubleStar string
+}
+
+// Mappings walks the base path and returns the mappings of the source files
+// matching the source patterns of the file groups, except the ignored ones.
+// If the hierarchy is not preserved, the directories common to all the
+// source files without a dest pattern are removed from their path in the
+// project.
+func (c *Config) Mappings() ([]*Mapping, error) {
+	var mappings []*Mapping
+	err := filepath.WalkDir(c.BasePath, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(c.BasePath, name)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, f := range c.Files {
+			doubleStar, ok := match(f.Source, rel)
+			if !ok || f.ignored(rel) {
+				continue
+			}
+			mappings = append(mappings, &Mapping{File: f, Source: rel, Path: rel, basePath: c.BasePath, doubleStar: doubleStar})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var hierarchy []*Mapping
+	for _, m := range mappings {
+		if m.File.Dest == "" {
+			hierarchy = append(hierarchy, m)
+			continue
+		}
+		dest, err := m.File.resolver().Resolve("/"+m.File.Dest, m.Source, nil)
+		if err != nil {
+			return nil, fmt.Errorf("config: dest of %s: %w", m.Source, err)
+		}
+		m.Path = strings.TrimPrefix(dest, "/")
+	}
+	if !c.PreserveHierarchy {
+		prefix := commonDir(hierarchy)
+		for _, m := range hierarchy {
+			m.Path = strings.TrimPrefix(m.Path, prefix)
+		}
+	}
+
+	sort.SliceStable(mappings, func(i, j int) bool { return mappings[i].Path < mappings[j].Path })
+	return mappings, nil
+}
+
+// LocalPath returns the path of the source file.
+func (m *Mapping) LocalPath() string {
+	return filepath.Join(m.basePath, filepath.FromSlash(m.Source))
+}
+
+// ExportPattern returns the export pattern of the file in the project,
+// i.e. the translation pattern with "**" replaced by the directories
+// of the source file.
+func (m *Mapping) ExportPattern() string {
+	return path.Clean("/" + strings.ReplaceAll(m.File.Translation, "**", m.doubleStar))
+}
+
+// TranslationPath returns the path of the translation of the source file
+// to the language. The languages mapping of the file overrides the codes
+// of the language.
+func (m *Mapping) TranslationPath(lang *model.Language) (string, error) {
+	p, err := m.File.resolver().Resolve(m.ExportPattern(), m.Path, lang)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(m.basePath, filepath.FromSlash(p)), nil
+}
+
+// AddFileRequest returns the request adding the source file to the project.
+// The storage and the directory or the branch have to be set by the caller.
+func (m *Mapping) AddFileRequest() *model.FileAddRequest {
+	return &model.FileAddRequest{
+		Name:                    path.Base(m.Path),
+		Type:                    m.File.Type,
+		ImportOptions:           m.File.importOptions(),
+		ExportOptions:           m.exportOptions(),
+		ExcludedTargetLanguages: m.File.ExcludedTargetLanguages,
+	}
+}
+
+// UpdateFileRequest returns the request updating the source file in the
+// project. The storage has to be set by the caller.
+func (m *Mapping) UpdateFileRequest() *model.FileUpdateRestoreRequest {
+	return &model.FileUpdateRestoreRequest{
+		UpdateOption:  m.File.UpdateOption,
+		ImportOptions: m.File.importOptions(),
+		ExportOptions: m.exportOptions(),
+	}
+}
+
+// UploadTranslationsRequest returns the request uploading the translations
+// of the file. The storage has to be set by the caller.
+func (m *Mapping) UploadTranslationsRequest(fileID int) *model.UploadTranslationsRequest {
+	return &model.UploadTranslationsRequest{FileID: fileID}
+}
+
+func (f *File) importOptions() model.FileImportOptions {
+	common := model.CommonFileImportOptions{ContentSegmentation: f.ContentSegmentation}
+	switch {
+	case f.FirstLineContainsHeader != nil || f.Scheme != nil:
+		return &model.SpreadsheetFileImportOptions{
+			FirstLineContainsHeader: f.FirstLineContainsHeader,
+			Scheme:                  f.Scheme,
+			CommonFileImportOptions: common,
+		}
+	case f.TranslateContent != nil || f.TranslateAttributes != nil || len(f.TranslatableElements) > 0:
+		return &model.XMLFileImportOptions{
+			TranslateContent:        f.TranslateContent,
+			TranslateAttributes:     f.TranslateAttributes,
+			TranslatableElements:    f.TranslatableElements,
+			CommonFileImportOptions: common,
+		}
+	case f.ContentSegmentation != nil:
+		return &common
+	default:
+		return nil
+	}
+}
+
+func (m *Mapping) exportOptions() model.FileExportOptions {
+	switch {
+	case m.File.EscapeQuotes != nil || m.File.EscapeSpecialCharacters != nil:
+		return &model.PropertyFileExportOptions{
+			ExportPattern:           m.ExportPattern(),
+			EscapeQuotes:            m.File.EscapeQuotes,
+			EscapeSpecialCharacters: m.File.EscapeSpecialCharacters,
+		}
+	case m.File.ExportQuotes != "":
+		return &model.JavaScriptFileExportOptions{ExportPattern: m.ExportPattern(), ExportQuotes: m.File.ExportQuotes}
+	default:
+		return &model.GeneralFileExportOptions{ExportPattern: m.ExportPattern()}
+	}
+}
+
+// ignored reports whether the source file matches an ignore pattern.
+func (f *File) ignored(name string) bool {
+	for _, pattern := range f.Ignore {
+		if _, ok := match(placeholders.ReplaceAllString(pattern, "*"), name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports whether the slash-separated name matches the pattern,
+// and returns the part of the name matched by "**". The pattern supports
+// the syntax of path.Match and "**" matching any number of directories.
+func match(pattern, name string) (string, bool) {
+	return matchSegments(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) (string, bool) {
+	for i, p := range pattern {
+		if p == "**" {
+			// Try the shortest match first.
+			for j := i; j <= len(name); j++ {
+				if _, ok := matchSegments(pattern[i+1:], name[j:]); ok {
+					return strings.Join(name[i:j], "/"), true
+				}
+			}
+			return "", false
+		}
+		if i >= len(name) {
+			return "", false
+		}
+		if ok, err := path.Match(p, name[i]); err != nil || !ok {
+			return "", false
+		}
+	}
+	return "", len(pattern) == len(name)
+}
+
+// commonDir returns the directory prefix, with the trailing slash,
+// common to the paths of the mappings.
+func commonDir(mappings []*Mapping) string {
+	if len(mappings) == 0 {
+		return ""
+	}
+	prefix := path.Dir(mappings[0].Path) + "/"
+	for _, m := range mappings[1:] {
+		for prefix != "./" && !strings.HasPrefix(m.Path, prefix) {
+			prefix = path.Dir(strings.TrimSuffix(prefix, "/")) + "/"
+		}
+	}
+	if prefix == "./" {
+		return ""
+	}
+	return prefix
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"regexp"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/exportpattern"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// placeholders matches the placeholders of the patterns, e.g. "%locale%".
+var placeholders = regexp.MustCompile(`%[a-z_]+%`)
+
+// resolver returns the resolver of the export patterns of the file.
+func (f *File) resolver() *exportpattern.Resolver {
+	return exportpattern.NewResolver(f.languageMapping())
+}
+
+// languageMapping converts the languages mapping of the file, keyed by
+// placeholder, to the language mapping of the API, keyed by language.
+func (f *File) languageMapping() map[string]model.LanguageMapping {
+	mapping := make(map[string]model.LanguageMapping)
+	for key, codes := range f.LanguagesMapping {
+		for id, code := range codes {
+			m := mapping[id]
+			switch key {
+			case "language", "name":
+				m.Name = code
+			case "two_letters_code":
+				m.TwoLettersCode = code
+			case "three_letters_code":
+				m.ThreeLettersCode = code
+			case "locale":
+				m.Locale = code
+			case "locale_with_underscore":
+				m.LocaleWithUnderscore = code
+			case "android_code":
+				m.AndroidCode = code
+			case "osx_code":
+				m.OSXCode = code
+			case "osx_locale":
+				m.OSXLocale = code
+			}
+			mapping[id] = m
+		}
+	}
+	return mapping
+}
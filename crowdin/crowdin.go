@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
 )
@@ -23,10 +25,16 @@ const (
 // Client is a Crowdin API client.
 type Client struct {
 	baseURL      *url.URL
-	token        string
+	uploadURL    *url.URL
+	graphQLURL   *url.URL
 	organization string
 	userAgent    string
 	httpClient   *http.Client
+	retryPolicy  *RetryPolicy
+	limiter      *rateLimiter
+	middlewares  []Middleware
+	logger       *slog.Logger
+	tokenSource  *reuseTokenSource
 
 	GraphQL *GraphQL
 
@@ -72,13 +80,12 @@ type Client struct {
 // To create an Enterprise client, use the WithOrganization() option as below:
 //
 //	client, err := crowdin.NewClient("token", crowdin.WithOrganization("organization"))
+//
+// To use OAuth or other short-lived tokens, pass an empty token
+// and the WithTokenSource() option.
 func NewClient(token string, opts ...ClientOption) (*Client, error) {
-	if token == "" {
-		return nil, errors.New("token cannot be empty")
-	}
 	u, _ := url.Parse(baseURL)
 	c := &Client{
-		token:     token,
 		baseURL:   u,
 		userAgent: userAgent,
 	}
@@ -89,11 +96,19 @@ func NewClient(token string, opts ...ClientOption) (*Client, error) {
 		}
 	}
 
+	if c.tokenSource == nil {
+		if token == "" {
+			return nil, errors.New("token cannot be empty")
+		}
+		c.tokenSource = newReuseTokenSource(StaticTokenSource(&Token{AccessToken: token}))
+	}
 	if c.httpClient == nil {
 		c.httpClient = http.DefaultClient
 	}
 	if c.organization != "" {
-		c.baseURL.Host = fmt.Sprintf("%s.%s", c.organization, c.baseURL.Host)
+		for _, u := range []*url.URL{c.baseURL, c.uploadURL, c.graphQLURL} {
+			withOrganization(u, c.organization)
+		}
 	}
 
 	// Initialize services.
@@ -146,6 +161,111 @@ func WithOrganization(organization string) ClientOption {
 	}
 }
 
+// WithBaseURL sets the base URL of the API, e.g. a proxy, a regional
+// endpoint or a local test server. The URL must be an absolute http(s)
+// URL and may contain a path prefix. The query and fragment are dropped.
+//
+// If the organization is set with WithOrganization and the URL points to
+// a crowdin.com host, the organization is prepended to the host. Other
+// URLs are used as is.
+func WithBaseURL(rawURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := parseBaseURL(rawURL)
+		if err != nil {
+			return err
+		}
+		c.baseURL = u
+		return nil
+	}
+}
+
+// WithUploadURL sets the base URL used for file uploads (e.g. storages).
+// If not set, the base URL of the API is used.
+func WithUploadURL(rawURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := parseBaseURL(rawURL)
+		if err != nil {
+			return err
+		}
+		c.uploadURL = u
+		return nil
+	}
+}
+
+// WithGraphQLURL sets the full URL of the GraphQL endpoint.
+// If not set, "api/graphql" relative to the base URL is used.
+func WithGraphQLURL(rawURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := parseBaseURL(rawURL)
+		if err != nil {
+			return err
+		}
+		u.Path = strings.TrimSuffix(u.Path, "/")
+		c.graphQLURL = u
+		return nil
+	}
+}
+
+// parseBaseURL validates and normalizes the base URL.
+func parseBaseURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid base URL %q: scheme must be http or https", rawURL)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q: host cannot be empty", rawURL)
+	}
+	if u.User != nil {
+		return nil, fmt.Errorf("invalid base URL %q: user info is not allowed", rawURL)
+	}
+
+	u.Host = strings.ToLower(u.Host)
+	u.RawQuery, u.Fragment, u.RawFragment = "", "", ""
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+		if u.RawPath != "" {
+			u.RawPath += "/"
+		}
+	}
+	return u, nil
+}
+
+// withOrganization prepends the organization to the crowdin.com host
+// unless it is already there.
+func withOrganization(u *url.URL, organization string) {
+	if u == nil {
+		return
+	}
+	host := u.Hostname()
+	if host != "crowdin.com" && !strings.HasSuffix(host, ".crowdin.com") {
+		return
+	}
+	if strings.HasPrefix(host, organization+".") {
+		return
+	}
+	u.Host = organization + "." + u.Host
+}
+
+// resolveURL resolves the path against the base URL keeping the path
+// prefix of the base URL. Absolute URLs are returned unchanged.
+func resolveURL(base *url.URL, path string) (*url.URL, error) {
+	rel, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	if rel.IsAbs() {
+		return rel, nil
+	}
+
+	u := base.JoinPath(rel.Path)
+	u.RawQuery = rel.RawQuery
+	return u, nil
+}
+
 // WithHTTPClient sets the custom HTTP client. If not set http.DefaultClient will be used.
 func WithHTTPClient(hc *http.Client) ClientOption {
 	return func(c *Client) error {
@@ -154,6 +274,29 @@ func WithHTTPClient(hc *http.Client) ClientOption {
 	}
 }
 
+// RoundTripFunc sends an API request and decodes the API response into v.
+type RoundTripFunc func(r *http.Request, v any) (*Response, error)
+
+// Middleware wraps a RoundTripFunc to observe or transform API calls.
+// Middlewares see the decoded *Response and the typed API errors
+// (e.g. *model.ErrorResponse) returned for the request.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware adds middlewares wrapped around every API call.
+// The first middleware is the outermost one. Middlewares are
+// invoked once per call, retries happen inside the chain.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) error {
+		for _, m := range mw {
+			if m == nil {
+				return errors.New("middleware cannot be nil")
+			}
+		}
+		c.middlewares = append(c.middlewares, mw...)
+		return nil
+	}
+}
+
 // RequestOption represents an option that can be used to modify a http.Request.
 type RequestOption func(*http.Request) error
 
@@ -167,13 +310,85 @@ func Header(key, value string) RequestOption {
 	}
 }
 
+type (
+	timeoutKey      struct{}
+	accessTokenKey  struct{}
+	organizationKey struct{}
+)
+
+// withContextValue sets the value in the context of the request.
+func withContextValue(r *http.Request, key, val any) {
+	*r = *r.WithContext(context.WithValue(r.Context(), key, val))
+}
+
+// Timeout sets the timeout for the request including retries.
+func Timeout(d time.Duration) RequestOption {
+	return func(r *http.Request) error {
+		if d <= 0 {
+			return errors.New("timeout must be positive")
+		}
+		withContextValue(r, timeoutKey{}, d)
+		return nil
+	}
+}
+
+// AccessToken overrides the token used to authorize the request. The
+// token is not refreshed if the request fails with 401 Unauthorized.
+func AccessToken(token string) RequestOption {
+	return func(r *http.Request) error {
+		if token == "" {
+			return errors.New("token cannot be empty")
+		}
+		r.Header.Set("Authorization", "Bearer "+token)
+		withContextValue(r, accessTokenKey{}, true)
+		return nil
+	}
+}
+
+// Organization overrides the organization the request is sent to,
+// so that one client can serve multiple Enterprise organizations.
+// An empty organization sends the request to crowdin.com.
+// It is only supported for the crowdin.com base URLs.
+func Organization(organization string) RequestOption {
+	return func(r *http.Request) error {
+		withContextValue(r, organizationKey{}, organization)
+		return nil
+	}
+}
+
+// applyOrganization replaces the organization in the request
+// host if it was overridden with the Organization option.
+func (c *Client) applyOrganization(r *http.Request) error {
+	organization, ok := r.Context().Value(organizationKey{}).(string)
+	if !ok {
+		return nil
+	}
+
+	host := r.URL.Hostname()
+	if c.organization != "" {
+		host = strings.TrimPrefix(host, c.organization+".")
+	}
+	if host != "crowdin.com" && !strings.HasSuffix(host, ".crowdin.com") {
+		return fmt.Errorf("organization cannot be overridden for %q host", r.URL.Hostname())
+	}
+	if organization != "" {
+		host = organization + "." + host
+	}
+	if port := r.URL.Port(); port != "" {
+		host += ":" + port
+	}
+
+	r.URL.Host = host
+	r.Host = host
+	return nil
+}
+
 // newRequest creates a new HTTP request with the provided method, path and body (if any).
 func (c *Client) newRequest(ctx context.Context, method, path string, body any, opts ...RequestOption) (*http.Request, error) {
-	rel, err := url.Parse(path)
+	u, err := resolveURL(c.baseURL, path)
 	if err != nil {
 		return nil, err
 	}
-	u := c.baseURL.ResolveReference(rel)
 
 	var buf io.ReadWriter
 	if body != nil && body != "" {
@@ -189,7 +404,9 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body any,
 	}
 
 	req.Header.Set("User-Agent", c.userAgent)
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	if err := c.authorize(req); err != nil {
+		return nil, err
+	}
 	if body != nil && body != "" {
 		req.Header.Set("Content-Type", "application/json")
 	}
@@ -199,31 +416,79 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body any,
 			return nil, err
 		}
 	}
+	if err := c.applyOrganization(req); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
+type uploadKey struct{}
+
+// withUpload returns a copy of ctx marking the requests made with it
+// as uploads, whose bodies are not logged.
+func withUpload(ctx context.Context) context.Context {
+	return context.WithValue(ctx, uploadKey{}, true)
+}
+
+// isUpload reports whether the request context is the one of an upload.
+func isUpload(ctx context.Context) bool {
+	upload, _ := ctx.Value(uploadKey{}).(bool)
+	return upload
+}
+
 // newUploadRequest creates an upload request.
 func (c *Client) newUploadRequest(ctx context.Context, method, path string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
-	rel, err := url.Parse(path)
+	base := c.baseURL
+	if c.uploadURL != nil {
+		base = c.uploadURL
+	}
+	u, err := resolveURL(base, path)
 	if err != nil {
 		return nil, err
 	}
-	u := c.baseURL.ResolveReference(rel)
 
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	// Seekable bodies (e.g. files) are not closed by the transport and
+	// can be rewound, so the request may be replayed on retries.
+	// In-memory readers are rewound by the http package itself.
+	var rs io.ReadSeeker
+	switch b := body.(type) {
+	case *bytes.Buffer, *bytes.Reader, *strings.Reader:
+	case io.ReadSeeker:
+		rs = b
+		body = io.NopCloser(b)
+	}
+
+	req, err := http.NewRequestWithContext(withUpload(ctx), method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
+	if rs != nil {
+		offset, err := rs.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			if _, err := rs.Seek(offset, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return io.NopCloser(rs), nil
+		}
+	}
 
 	req.Header.Set("User-Agent", c.userAgent)
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	if err := c.authorize(req); err != nil {
+		return nil, err
+	}
 
 	for _, opt := range opts {
 		if err := opt(req); err != nil {
 			return nil, err
 		}
 	}
+	if err := c.applyOrganization(req); err != nil {
+		return nil, err
+	}
 
 	if req.Header.Get("Content-Type") == "" {
 		req.Header.Set("Content-Type", "application/octet-stream")
@@ -232,8 +497,83 @@ func (c *Client) newUploadRequest(ctx context.Context, method, path string, body
 	return req, nil
 }
 
-// do sends an API request and returns the API response.
-func (c *Client) do(r *http.Request, v any) (*Response, error) {
+// do sends an API request through the middleware chain
+// and returns the API response. The operation names the call,
+// unless a service method has named it in the context.
+func (c *Client) do(r *http.Request, v any, operation string) (*Response, error) {
+	if d, ok := r.Context().Value(timeoutKey{}).(time.Duration); ok {
+		ctx, cancel := context.WithTimeout(r.Context(), d)
+		defer cancel()
+		r = r.WithContext(ctx)
+	}
+
+	if len(c.middlewares) == 0 && c.logger == nil {
+		return c.send(r, v)
+	}
+
+	if name, ok := r.Context().Value(operationKey{}).(string); ok {
+		operation = name
+	}
+	info := &CallInfo{Operation: operation}
+	r = r.WithContext(context.WithValue(r.Context(), callInfoKey{}, info))
+
+	next := c.send
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
+	}
+	return next(r, v)
+}
+
+// send sends an API request and returns the API response.
+// If the token is rejected, the request is sent once again with
+// a new token obtained from the token source.
+func (c *Client) send(r *http.Request, v any) (*Response, error) {
+	resp, err := c.sendAttempts(r, v)
+	if resp != nil && resp.Response != nil && resp.StatusCode == http.StatusUnauthorized && c.reauthorize(r) {
+		return c.sendAttempts(r, v)
+	}
+	return resp, err
+}
+
+// sendAttempts sends an API request, retrying it
+// if a retry policy is configured.
+func (c *Client) sendAttempts(r *http.Request, v any) (*Response, error) {
+	if c.retryPolicy != nil {
+		return c.doWithRetry(r, v)
+	}
+	return c.roundTrip(r, v)
+}
+
+// roundTrip sends a single API request and decodes the API response.
+func (c *Client) roundTrip(r *http.Request, v any) (response *Response, err error) {
+	info, _ := CallInfoFromContext(r.Context())
+	if info != nil {
+		info.Attempts++
+	}
+
+	if c.limiter != nil {
+		release, waited, err := c.limiter.wait(r.Context())
+		if info != nil {
+			info.RateLimitWait += waited
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	var body []byte
+	if c.logger != nil {
+		start := time.Now()
+		defer func() {
+			var resp *http.Response
+			if response != nil {
+				resp = response.Response
+			}
+			c.logRequest(r, resp, body, time.Since(start), err)
+		}()
+	}
+
 	resp, err := c.httpClient.Do(r)
 	if err != nil {
 		return nil, err
@@ -245,8 +585,8 @@ func (c *Client) do(r *http.Request, v any) (*Response, error) {
 		}
 	}()
 
-	response := &Response{Response: resp}
-	body, err := io.ReadAll(resp.Body)
+	response = newResponse(resp)
+	body, err = io.ReadAll(resp.Body)
 	if err != nil {
 		return response, fmt.Errorf("client: error reading response body: %w", err)
 	}
@@ -293,7 +633,7 @@ func (c *Client) Post(ctx context.Context, path string, body, v any, opts ...Req
 		return nil, err
 	}
 
-	return c.do(req, v)
+	return c.do(req, v, "Client.Post")
 }
 
 // Upload makes a POST request to the specified path with a file.
@@ -303,11 +643,11 @@ func (c *Client) Upload(ctx context.Context, path string, file io.Reader, v any,
 		return nil, err
 	}
 
-	return c.do(req, v)
+	return c.do(req, v, "Client.Upload")
 }
 
 // Patch makes a PATCH request to the specified path.
-func (c *Client) Patch(ctx context.Context, path string, body, v any) (*Response, error) {
+func (c *Client) Patch(ctx context.Context, path string, body, v any, opts ...RequestOption) (*Response, error) {
 	// Body can be a single object or a slice of objects.
 	// Check if the body is a slice of RequestValidator and validate each item.
 	switch body := body.(type) {
@@ -326,28 +666,28 @@ func (c *Client) Patch(ctx context.Context, path string, body, v any) (*Response
 		}
 	}
 
-	req, err := c.newRequest(ctx, "PATCH", path, body)
+	req, err := c.newRequest(ctx, "PATCH", path, body, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.do(req, v)
+	return c.do(req, v, "Client.Patch")
 }
 
 // Put makes a PUT request to the specified path.
-func (c *Client) Put(ctx context.Context, path string, body, v any) (*Response, error) {
+func (c *Client) Put(ctx context.Context, path string, body, v any, opts ...RequestOption) (*Response, error) {
 	if rv, ok := body.(RequestValidator); ok {
 		if err := rv.Validate(); err != nil {
 			return nil, err
 		}
 	}
 
-	req, err := c.newRequest(ctx, "PUT", path, body)
+	req, err := c.newRequest(ctx, "PUT", path, body, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.do(req, v)
+	return c.do(req, v, "Client.Put")
 }
 
 // ListOptionsProvider interface provides query parameters for list methods.
@@ -358,30 +698,30 @@ type ListOptionsProvider interface {
 }
 
 // Get makes a GET request to the specified path.
-func (c *Client) Get(ctx context.Context, path string, params ListOptionsProvider, v any) (*Response, error) {
+func (c *Client) Get(ctx context.Context, path string, params ListOptionsProvider, v any, opts ...RequestOption) (*Response, error) {
 	if params != nil {
-		if opts, ok := params.Values(); ok {
-			path += "?" + opts.Encode()
+		if values, ok := params.Values(); ok {
+			path += "?" + values.Encode()
 		}
 	}
 
-	req, err := c.newRequest(ctx, "GET", path, nil)
+	req, err := c.newRequest(ctx, "GET", path, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.do(req, v)
+	return c.do(req, v, "Client.Get")
 }
 
 // Delete makes a DELETE request to the specified path.
 // If the provided parameter v is not nil, the result will be unmarshaled into it.
-func (c *Client) Delete(ctx context.Context, path string, v any) (*Response, error) {
-	req, err := c.newRequest(ctx, "DELETE", path, nil)
+func (c *Client) Delete(ctx context.Context, path string, v any, opts ...RequestOption) (*Response, error) {
+	req, err := c.newRequest(ctx, "DELETE", path, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.do(req, v)
+	return c.do(req, v, "Client.Delete")
 }
 
 // handleErrorResponse checks the API response for errors and returns
@@ -389,53 +729,30 @@ func (c *Client) Delete(ctx context.Context, path string, v any) (*Response, err
 func handleErrorResponse(res *http.Request, resp *http.Response, body []byte) error {
 	errorResp := determineErrorType(res, resp, body)
 	if err := json.Unmarshal(body, errorResp); err != nil {
-		return fmt.Errorf("client: server returned %d status code", resp.StatusCode)
+		return &model.ErrorResponse{Response: resp}
 	}
 	return errorResp
 }
 
 func determineErrorType(res *http.Request, resp *http.Response, body []byte) error {
-	switch resp.StatusCode {
-	case http.StatusBadRequest:
-		if strings.Contains(res.URL.Path, "graphql") {
+	if resp.StatusCode == http.StatusBadRequest {
+		if isGraphQL(res) {
 			return &model.GraphQLErrorResponse{}
 		}
 
 		var b map[string]any
-		if err := json.Unmarshal(body, &b); err != nil {
-			return err
-		}
-
-		if errors, ok := b["errors"].([]any); ok && len(errors) > 0 {
-			if firstErr, ok := errors[0].(map[string]any); ok {
-				if _, ok := firstErr["index"]; ok {
-					return &model.BatchValidationErrorResponse{Response: resp, Status: resp.StatusCode}
+		if err := json.Unmarshal(body, &b); err == nil {
+			if errors, ok := b["errors"].([]any); ok && len(errors) > 0 {
+				if firstErr, ok := errors[0].(map[string]any); ok {
+					if _, ok := firstErr["index"]; ok {
+						return &model.BatchValidationErrorResponse{Response: resp, Status: resp.StatusCode}
+					}
 				}
+				return &model.ValidationErrorResponse{Response: resp, Status: resp.StatusCode}
 			}
-			return &model.ValidationErrorResponse{Response: resp, Status: resp.StatusCode}
 		}
-		return fmt.Errorf("client: error while determining error type")
-	default:
-		return &model.ErrorResponse{Response: resp}
 	}
-}
-
-// Response is a Crowdin response that wraps http.Response.
-type Response struct {
-	*http.Response
-
-	Pagination model.Pagination
-}
-
-// populatePagination reads the pagination information from the response
-// body and sets it to the Response struct.
-func (r *Response) populatePagination(body []byte) error {
-	p := new(model.PaginationResponse)
-	if err := json.Unmarshal(body, p); err != nil {
-		return err
-	}
-	r.Pagination = p.Pagination
-	return nil
+	return &model.ErrorResponse{Response: resp}
 }
 
 // ToPtr is a helper function that returns a pointer
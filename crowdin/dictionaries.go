@@ -20,11 +20,12 @@ type DictionariesService struct {
 // List returns a list of organization dictionaries.
 //
 // https://developer.crowdin.com/api/v2/#tag/Dictionaries
-func (s *DictionariesService) List(ctx context.Context, projectID int, opts *model.DictionariesListOptions) (
+func (s *DictionariesService) List(ctx context.Context, projectID int, opts *model.DictionariesListOptions, reqOpts ...RequestOption) (
 	[]*model.Dictionary, *Response, error,
 ) {
+	ctx = withOperation(ctx, "DictionariesService.List")
 	res := new(model.DictionariesListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/dictionaries", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/dictionaries", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -46,11 +47,12 @@ func (s *DictionariesService) List(ctx context.Context, projectID int, opts *mod
 //   - Value (array) - value to set. Required for add operation.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.dictionaries.patch
-func (s *DictionariesService) Edit(ctx context.Context, projectID int, languageID string, req []*model.UpdateRequest) (
+func (s *DictionariesService) Edit(ctx context.Context, projectID int, languageID string, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Dictionary, *Response, error,
 ) {
+	ctx = withOperation(ctx, "DictionariesService.Edit")
 	res := new(model.DictionaryResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/dictionaries/%s", projectID, languageID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/dictionaries/%s", projectID, languageID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
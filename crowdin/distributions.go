@@ -17,11 +17,12 @@ type DistributionsService struct {
 // List returns a list of distributions in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.getMany
-func (s *DistributionsService) List(ctx context.Context, projectID int, opts *model.ListOptions) (
+func (s *DistributionsService) List(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.Distribution, *Response, error,
 ) {
+	ctx = withOperation(ctx, "DistributionsService.List")
 	res := new(model.DistributionsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -37,9 +38,10 @@ func (s *DistributionsService) List(ctx context.Context, projectID int, opts *mo
 // Get returns information about a distribution.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.get
-func (s *DistributionsService) Get(ctx context.Context, projectID int, hash string) (*model.Distribution, *Response, error) {
+func (s *DistributionsService) Get(ctx context.Context, projectID int, hash string, reqOpts ...RequestOption) (*model.Distribution, *Response, error) {
+	ctx = withOperation(ctx, "DistributionsService.Get")
 	res := new(model.DistributionResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -47,11 +49,12 @@ func (s *DistributionsService) Get(ctx context.Context, projectID int, hash stri
 // Add creates a new distribution.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.post
-func (s *DistributionsService) Add(ctx context.Context, projectID int, req *model.DistributionAddRequest) (
+func (s *DistributionsService) Add(ctx context.Context, projectID int, req *model.DistributionAddRequest, reqOpts ...RequestOption) (
 	*model.Distribution, *Response, error,
 ) {
+	ctx = withOperation(ctx, "DistributionsService.Add")
 	res := new(model.DistributionResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -64,11 +67,12 @@ func (s *DistributionsService) Add(ctx context.Context, projectID int, req *mode
 //   - Value (string) - New alue to set.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.patch
-func (s *DistributionsService) Edit(ctx context.Context, projectID int, hash string, req []*model.UpdateRequest) (
+func (s *DistributionsService) Edit(ctx context.Context, projectID int, hash string, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Distribution, *Response, error,
 ) {
+	ctx = withOperation(ctx, "DistributionsService.Edit")
 	res := new(model.DistributionResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -76,18 +80,20 @@ func (s *DistributionsService) Edit(ctx context.Context, projectID int, hash str
 // Delete removes a distribution from the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.delete
-func (s *DistributionsService) Delete(ctx context.Context, projectID int, hash string) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash), nil)
+func (s *DistributionsService) Delete(ctx context.Context, projectID int, hash string, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "DistributionsService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s", projectID, hash), nil, reqOpts...)
 }
 
 // GetRelease returns information about the distribution release.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.release.get
-func (s *DistributionsService) GetRelease(ctx context.Context, projectID int, hash string) (
+func (s *DistributionsService) GetRelease(ctx context.Context, projectID int, hash string, reqOpts ...RequestOption) (
 	*model.DistributionRelease, *Response, error,
 ) {
+	ctx = withOperation(ctx, "DistributionsService.GetRelease")
 	res := new(model.DistributionReleaseResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s/release", projectID, hash), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s/release", projectID, hash), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -95,11 +101,12 @@ func (s *DistributionsService) GetRelease(ctx context.Context, projectID int, ha
 // Release releases the distribution.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.distributions.release.post
-func (s *DistributionsService) Release(ctx context.Context, projectID int, hash string) (
+func (s *DistributionsService) Release(ctx context.Context, projectID int, hash string, reqOpts ...RequestOption) (
 	*model.DistributionRelease, *Response, error,
 ) {
+	ctx = withOperation(ctx, "DistributionsService.Release")
 	res := new(model.DistributionReleaseResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s/release", projectID, hash), "", res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/distributions/%s/release", projectID, hash), "", res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -0,0 +1,459 @@
+package crowdin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// ErrDownloadMismatch is matched by the errors returned when the size
+// or the checksum of the downloaded content is not the expected one.
+var ErrDownloadMismatch = errors.New("crowdin: downloaded content mismatch")
+
+// DownloadOptions specifies the optional parameters to the download methods.
+type DownloadOptions struct {
+	// Refresh returns a new download link. It is called when the link
+	// has expired (see model.DownloadLink.ExpireIn) or has been rejected
+	// by the server, e.g.:
+	//
+	//	func(ctx context.Context) (*model.DownloadLink, error) {
+	//		link, _, err := client.Translations.DownloadProjectTranslations(ctx, projectID, buildID)
+	//		return link, err
+	//	}
+	Refresh func(ctx context.Context) (*model.DownloadLink, error)
+	// Size is the expected size of the content in bytes.
+	// Zero value skips the check.
+	Size int64
+	// Checksum is the expected hex-encoded checksum of the content.
+	// Empty value skips the check.
+	Checksum string
+	// Hash returns the hash used to compute the checksum.
+	// Defaults to SHA-256.
+	Hash func() hash.Hash
+	// Progress is called with the number of bytes downloaded and the
+	// total size of the content, or -1 if the size is unknown.
+	Progress func(written, total int64)
+	// MaxResumes is the maximum number of times an interrupted download
+	// is resumed with a Range request. Defaults to 3.
+	MaxResumes int
+}
+
+// Download streams the content of the download link to the writer and
+// returns the number of bytes written. If the download is interrupted,
+// it is resumed from the last written byte.
+//
+// The request is sent with the HTTP client of the client, but without
+// the Authorization header, so the token is never sent to the host of
+// the link.
+func (c *Client) Download(ctx context.Context, link *model.DownloadLink, w io.Writer, opts *DownloadOptions) (int64, error) {
+	d, err := c.newDownload(link, opts)
+	if err != nil {
+		return 0, err
+	}
+	return d.run(ctx, w, 0)
+}
+
+// DownloadFile downloads the content of the download link to the file at
+// path and returns the size of the file. The content is written to the
+// path with the ".part" suffix first, which is renamed once the download
+// is complete and verified.
+//
+// If the download fails, the ETag of the content is stored next to the
+// ".part" file, with the ".part.etag" suffix, and the next download resumes
+// from the end of the ".part" file only if the content has the same ETag.
+// A ".part" file whose content cannot be identified this way is truncated
+// and downloaded again.
+func (c *Client) DownloadFile(ctx context.Context, link *model.DownloadLink, path string, opts *DownloadOptions) (int64, error) {
+	d, err := c.newDownload(link, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	part := path + ".part"
+	etagFile := part + ".etag"
+	f, err := os.OpenFile(part, os.O_CREATE|os.O_RDWR, 0o644) //nolint:gosec // downloaded files are not secret
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	d.restart = func() error {
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if d.hash != nil {
+			d.hash.Reset()
+		}
+		offset = 0
+		return nil
+	}
+	if offset > 0 && !d.resumable(etagFile) {
+		if err := d.restart(); err != nil {
+			return 0, err
+		}
+	}
+	if offset > 0 && d.hash != nil {
+		if _, err := io.Copy(d.hash, io.NewSectionReader(f, 0, offset)); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := d.run(ctx, f, offset)
+	if err != nil {
+		if errors.Is(err, ErrDownloadMismatch) {
+			_ = os.Remove(part)
+			_ = os.Remove(etagFile)
+		} else {
+			d.saveETag(etagFile)
+		}
+		return offset + n, err
+	}
+	if err := f.Close(); err != nil {
+		return offset + n, err
+	}
+
+	_ = os.Remove(etagFile)
+	return offset + n, os.Rename(part, path)
+}
+
+// resumable reports whether the ".part" file holds the beginning of the
+// content of the link, according to the ETag stored in the file.
+func (d *download) resumable(etagFile string) bool {
+	b, err := os.ReadFile(etagFile) //nolint:gosec // the path is derived from the path of the download
+	if err != nil {
+		return false
+	}
+	etag := strings.TrimSpace(string(b))
+	if !isStrongETag(etag) || (d.etag != "" && d.etag != etag) {
+		return false
+	}
+	d.etag = etag
+	return true
+}
+
+// saveETag stores the ETag of the content to resume the download,
+// or removes the file if the content has no strong ETag.
+func (d *download) saveETag(etagFile string) {
+	if !isStrongETag(d.etag) {
+		_ = os.Remove(etagFile)
+		return
+	}
+	_ = os.WriteFile(etagFile, []byte(d.etag), 0o644) //nolint:gosec // ETags are not secret
+}
+
+// download is the state of a download.
+type download struct {
+	client *Client
+	link   *model.DownloadLink
+	opts   DownloadOptions
+	hash   hash.Hash
+	etag   string
+	// restart, if not nil, truncates the destination of the download,
+	// e.g. when the content has changed while resuming, to download it again.
+	restart func() error
+}
+
+func (c *Client) newDownload(link *model.DownloadLink, opts *DownloadOptions) (*download, error) {
+	if link == nil || link.URL == "" {
+		return nil, errors.New("download link cannot be empty")
+	}
+
+	d := &download{client: c, link: link}
+	if opts != nil {
+		d.opts = *opts
+	}
+	if d.opts.MaxResumes <= 0 {
+		d.opts.MaxResumes = 3
+	}
+	if d.opts.Checksum != "" {
+		if d.opts.Hash == nil {
+			d.opts.Hash = sha256.New
+		}
+		d.hash = d.opts.Hash()
+	}
+	if link.Etag != nil {
+		d.etag = *link.Etag
+	}
+	return d, nil
+}
+
+// run downloads the content starting at the offset, resuming
+// the download if it is interrupted.
+func (d *download) run(ctx context.Context, w io.Writer, offset int64) (int64, error) {
+	var (
+		written   int64
+		total     int64 = -1
+		resumes   int
+		refreshed bool
+	)
+
+	for {
+		if d.expired() && d.opts.Refresh != nil {
+			if err := d.refresh(ctx); err != nil {
+				return written, err
+			}
+		}
+
+		resp, err := d.get(ctx, offset+written)
+		if err == nil && resp.StatusCode == http.StatusOK && offset+written > 0 && d.restart != nil && d.changed(resp) {
+			// The server sent the whole new content, see If-Range.
+			if err := d.restart(); err != nil {
+				resp.Body.Close()
+				return written, err
+			}
+			offset, written = 0, 0
+			d.etag = resp.Header.Get("ETag")
+		}
+		if err == nil {
+			var retry bool
+			total, retry, err = d.checkResponse(resp, offset+written, refreshed)
+			if retry {
+				resp.Body.Close()
+				if err = d.refresh(ctx); err != nil {
+					return written, err
+				}
+				refreshed = true
+				continue
+			}
+		}
+		if err == nil {
+			var n int64
+			n, err = d.copy(w, resp.Body, offset+written, total)
+			written += n
+			resp.Body.Close()
+
+			if err == nil && total >= 0 && offset+written < total {
+				err = io.ErrUnexpectedEOF
+			}
+			if err == nil {
+				break
+			}
+		}
+
+		var werr *writeError
+		if errors.As(err, &werr) || ctx.Err() != nil || resumes >= d.opts.MaxResumes || !isResumable(err) {
+			if werr != nil {
+				return written, werr.err
+			}
+			return written, err
+		}
+		resumes++
+	}
+
+	return written, d.verify(offset + written)
+}
+
+// get requests the content of the link starting at the offset.
+func (d *download) get(ctx context.Context, offset int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.link.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", d.client.userAgent)
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if isStrongETag(d.etag) {
+			req.Header.Set("If-Range", d.etag)
+		}
+	}
+
+	return d.client.httpClient.Do(req)
+}
+
+// checkResponse checks the status of the response and returns the total
+// size of the content. It reports whether the link should be refreshed
+// and the request sent again.
+func (d *download) checkResponse(resp *http.Response, offset int64, refreshed bool) (total int64, retry bool, err error) {
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if offset > 0 {
+			if d.changed(resp) {
+				resp.Body.Close()
+				return -1, false, fmt.Errorf("%w: content has changed while resuming", ErrDownloadMismatch)
+			}
+			// The server does not support ranges, skip the downloaded bytes.
+			if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+				resp.Body.Close()
+				return -1, false, err
+			}
+		}
+		if d.etag == "" {
+			d.etag = resp.Header.Get("ETag")
+		}
+		if resp.ContentLength >= 0 {
+			return resp.ContentLength, false, nil
+		}
+		return -1, false, nil
+	case http.StatusPartialContent:
+		if d.changed(resp) {
+			resp.Body.Close()
+			return -1, false, fmt.Errorf("%w: content has changed while resuming", ErrDownloadMismatch)
+		}
+		start, size, ok := parseContentRange(resp.Header.Get("Content-Range"))
+		if !ok || start != offset {
+			resp.Body.Close()
+			return -1, false, fmt.Errorf("crowdin: unexpected content range %q", resp.Header.Get("Content-Range"))
+		}
+		return size, false, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		resp.Body.Close()
+		if _, size, ok := parseContentRange(resp.Header.Get("Content-Range")); ok && size == offset {
+			// The content has been downloaded completely.
+			resp.Body = http.NoBody
+			return size, false, nil
+		}
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusGone:
+		// Signed links are rejected once they expire.
+		if d.opts.Refresh != nil && !refreshed {
+			return -1, true, nil
+		}
+	}
+
+	resp.Body.Close()
+	return -1, false, &model.ErrorResponse{Response: resp}
+}
+
+// changed reports whether the ETag of the response
+// differs from the one of the content being downloaded.
+func (d *download) changed(resp *http.Response) bool {
+	etag := resp.Header.Get("ETag")
+	return etag != "" && d.etag != "" && etag != d.etag
+}
+
+// copy copies the body to the writer reporting the progress.
+func (d *download) copy(w io.Writer, body io.Reader, offset, total int64) (int64, error) {
+	buf := make([]byte, 32*1024)
+
+	var written int64
+	for {
+		n, rerr := body.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return written, &writeError{err}
+			}
+			if d.hash != nil {
+				d.hash.Write(buf[:n])
+			}
+			written += int64(n)
+			if d.opts.Progress != nil {
+				d.opts.Progress(offset+written, total)
+			}
+		}
+		if rerr == io.EOF {
+			return written, nil
+		}
+		if rerr != nil {
+			return written, rerr
+		}
+	}
+}
+
+// verify checks the size and the checksum of the content.
+func (d *download) verify(size int64) error {
+	if d.opts.Size > 0 && size != d.opts.Size {
+		return fmt.Errorf("%w: size is %d, want %d", ErrDownloadMismatch, size, d.opts.Size)
+	}
+	if d.hash != nil {
+		if sum := hex.EncodeToString(d.hash.Sum(nil)); !strings.EqualFold(sum, d.opts.Checksum) {
+			return fmt.Errorf("%w: checksum is %s, want %s", ErrDownloadMismatch, sum, d.opts.Checksum)
+		}
+	}
+	return nil
+}
+
+// expired reports whether the link has expired.
+func (d *download) expired() bool {
+	expiry, err := time.Parse(time.RFC3339, d.link.ExpireIn)
+	if err != nil {
+		return false
+	}
+	return time.Now().Add(expiryDelta).After(expiry)
+}
+
+// refresh replaces the link with a new one.
+func (d *download) refresh(ctx context.Context) error {
+	link, err := d.opts.Refresh(ctx)
+	if err != nil {
+		return fmt.Errorf("crowdin: cannot refresh download link: %w", err)
+	}
+	if link == nil || link.URL == "" {
+		return errors.New("crowdin: refreshed download link is empty")
+	}
+	d.link = link
+	return nil
+}
+
+// writeError is an error returned by the writer of a download.
+type writeError struct {
+	err error
+}
+
+func (e *writeError) Error() string { return e.err.Error() }
+
+func (e *writeError) Unwrap() error { return e.err }
+
+// isStrongETag reports whether the ETag can be used with If-Range.
+func isStrongETag(etag string) bool {
+	return etag != "" && !strings.HasPrefix(etag, "W/")
+}
+
+// isResumable reports whether the download can be resumed after the error.
+func isResumable(err error) bool {
+	var errResp *model.ErrorResponse
+	if errors.As(err, &errResp) {
+		return errors.Is(err, ErrServerError) || errors.Is(err, ErrRateLimited)
+	}
+	return !errors.Is(err, ErrDownloadMismatch)
+}
+
+// parseContentRange parses the Content-Range header,
+// e.g. "bytes 100-199/200" or "bytes */200".
+func parseContentRange(v string) (start, size int64, ok bool) {
+	v, found := strings.CutPrefix(v, "bytes ")
+	if !found {
+		return 0, 0, false
+	}
+	rng, sizeStr, found := strings.Cut(v, "/")
+	if !found {
+		return 0, 0, false
+	}
+
+	size = -1
+	if sizeStr != "*" {
+		var err error
+		if size, err = strconv.ParseInt(sizeStr, 10, 64); err != nil {
+			return 0, 0, false
+		}
+	}
+	if rng == "*" {
+		return 0, size, true
+	}
+
+	startStr, _, found := strings.Cut(rng, "-")
+	if !found {
+		return 0, 0, false
+	}
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, size, true
+}
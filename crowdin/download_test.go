@@ -0,0 +1,320 @@
+package crowdin
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+var downloadContent = []byte(strings.Repeat("crowdin download content ", 4096))
+
+// setupDownload starts a CDN server serving the content with Range support.
+// The handler is called before the content is served and can write
+// the response itself by returning true.
+func setupDownload(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, n int) bool) (*Client, string) {
+	t.Helper()
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("Authorization header is sent to the CDN: %q", r.Header.Get("Authorization"))
+		}
+		n := int(atomic.AddInt32(&calls, 1))
+		if handler != nil && handler(w, r, n) {
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		http.ServeContent(w, r, "file.zip", time.Time{}, bytes.NewReader(downloadContent))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient("access_token")
+	require.NoError(t, err)
+
+	return client, server.URL
+}
+
+// interrupt writes the first half of the content and closes the connection.
+func interrupt(w http.ResponseWriter) {
+	w.Header().Set("Content-Length", strconv.Itoa(len(downloadContent)))
+	w.Header().Set("ETag", `"v1"`)
+	_, _ = w.Write(downloadContent[:len(downloadContent)/2])
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	panic(http.ErrAbortHandler)
+}
+
+func checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDownload(t *testing.T) {
+	client, url := setupDownload(t, nil)
+
+	var progress []int64
+	var buf bytes.Buffer
+	n, err := client.Download(context.Background(), &model.DownloadLink{URL: url + "/file.zip"}, &buf, &DownloadOptions{
+		Size:     int64(len(downloadContent)),
+		Checksum: checksum(downloadContent),
+		Progress: func(written, total int64) {
+			assert.Equal(t, int64(len(downloadContent)), total)
+			progress = append(progress, written)
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(len(downloadContent)), n)
+	assert.Equal(t, downloadContent, buf.Bytes())
+	require.NotEmpty(t, progress)
+	assert.Equal(t, n, progress[len(progress)-1])
+}
+
+func TestDownload_resume(t *testing.T) {
+	var ranges []string
+	client, url := setupDownload(t, func(w http.ResponseWriter, r *http.Request, n int) bool {
+		ranges = append(ranges, r.Header.Get("Range")+" "+r.Header.Get("If-Range"))
+		if n == 1 {
+			interrupt(w)
+		}
+		return false
+	})
+
+	var buf bytes.Buffer
+	n, err := client.Download(context.Background(), &model.DownloadLink{URL: url}, &buf, &DownloadOptions{
+		Checksum: checksum(downloadContent),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(len(downloadContent)), n)
+	assert.Equal(t, downloadContent, buf.Bytes())
+	require.Len(t, ranges, 2)
+	assert.Equal(t, " ", ranges[0])
+	assert.Regexp(t, `^bytes=\d+- "v1"$`, ranges[1])
+}
+
+func TestDownload_resumeLimit(t *testing.T) {
+	client, url := setupDownload(t, func(w http.ResponseWriter, _ *http.Request, _ int) bool {
+		interrupt(w)
+		return true
+	})
+
+	_, err := client.Download(context.Background(), &model.DownloadLink{URL: url}, &bytes.Buffer{}, &DownloadOptions{MaxResumes: 1})
+	require.Error(t, err)
+}
+
+func TestDownload_checksumMismatch(t *testing.T) {
+	client, url := setupDownload(t, nil)
+
+	sum := md5.Sum([]byte("other"))
+	_, err := client.Download(context.Background(), &model.DownloadLink{URL: url}, &bytes.Buffer{}, &DownloadOptions{
+		Checksum: hex.EncodeToString(sum[:]),
+		Hash:     md5.New,
+	})
+	require.ErrorIs(t, err, ErrDownloadMismatch)
+	assert.Contains(t, err.Error(), "checksum is")
+}
+
+func TestDownload_sizeMismatch(t *testing.T) {
+	client, url := setupDownload(t, nil)
+
+	_, err := client.Download(context.Background(), &model.DownloadLink{URL: url}, &bytes.Buffer{}, &DownloadOptions{Size: 1})
+	require.ErrorIs(t, err, ErrDownloadMismatch)
+}
+
+func TestDownload_refreshExpiredLink(t *testing.T) {
+	client, url := setupDownload(t, func(w http.ResponseWriter, r *http.Request, _ int) bool {
+		if r.URL.Path != "/fresh" {
+			t.Errorf("expired link is requested: %s", r.URL.Path)
+		}
+		return false
+	})
+
+	expired := &model.DownloadLink{URL: url + "/expired", ExpireIn: time.Now().Add(-time.Minute).Format(time.RFC3339)}
+	var buf bytes.Buffer
+	_, err := client.Download(context.Background(), expired, &buf, &DownloadOptions{
+		Refresh: func(context.Context) (*model.DownloadLink, error) {
+			return &model.DownloadLink{URL: url + "/fresh", ExpireIn: time.Now().Add(time.Hour).Format(time.RFC3339)}, nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, downloadContent, buf.Bytes())
+}
+
+func TestDownload_refreshRejectedLink(t *testing.T) {
+	client, url := setupDownload(t, func(w http.ResponseWriter, r *http.Request, _ int) bool {
+		if r.URL.Path == "/rejected" {
+			w.WriteHeader(http.StatusForbidden)
+			return true
+		}
+		return false
+	})
+
+	var refreshes int
+	var buf bytes.Buffer
+	_, err := client.Download(context.Background(), &model.DownloadLink{URL: url + "/rejected"}, &buf, &DownloadOptions{
+		Refresh: func(context.Context) (*model.DownloadLink, error) {
+			refreshes++
+			return &model.DownloadLink{URL: url + "/fresh"}, nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshes)
+	assert.Equal(t, downloadContent, buf.Bytes())
+
+	_, err = client.Download(context.Background(), &model.DownloadLink{URL: url + "/rejected"}, &buf, nil)
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestDownloadFile(t *testing.T) {
+	client, url := setupDownload(t, nil)
+
+	path := filepath.Join(t.TempDir(), "file.zip")
+	n, err := client.DownloadFile(context.Background(), &model.DownloadLink{URL: url}, path, &DownloadOptions{
+		Checksum: checksum(downloadContent),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(downloadContent)), n)
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, downloadContent, b)
+	assert.NoFileExists(t, path+".part")
+}
+
+func TestDownloadFile_resumePartFile(t *testing.T) {
+	var ranges []string
+	client, url := setupDownload(t, func(_ http.ResponseWriter, r *http.Request, _ int) bool {
+		ranges = append(ranges, r.Header.Get("Range")+" "+r.Header.Get("If-Range"))
+		return false
+	})
+
+	path := filepath.Join(t.TempDir(), "file.zip")
+	require.NoError(t, os.WriteFile(path+".part", downloadContent[:100], 0o600))
+	require.NoError(t, os.WriteFile(path+".part.etag", []byte(`"v1"`), 0o600))
+
+	n, err := client.DownloadFile(context.Background(), &model.DownloadLink{URL: url}, path, &DownloadOptions{
+		Checksum: checksum(downloadContent),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(downloadContent)), n)
+	assert.Equal(t, []string{`bytes=100- "v1"`}, ranges)
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, downloadContent, b)
+	assert.NoFileExists(t, path+".part.etag")
+}
+
+func TestDownloadFile_unverifiedPartFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		etag   string // the content of the ".part.etag" file, if any
+		link   *string
+		ranges []string
+	}{
+		{"no ETag", "", nil, []string{" "}},
+		{"weak ETag", `W/"v1"`, nil, []string{" "}},
+		{"other link", `"v0"`, ToPtr(`"v1"`), []string{" "}},
+		{"other content", `"v0"`, nil, []string{`bytes=25- "v0"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ranges []string
+			client, url := setupDownload(t, func(_ http.ResponseWriter, r *http.Request, _ int) bool {
+				ranges = append(ranges, r.Header.Get("Range")+" "+r.Header.Get("If-Range"))
+				return false
+			})
+
+			path := filepath.Join(t.TempDir(), "file.zip")
+			require.NoError(t, os.WriteFile(path+".part", []byte("leftover of another build"), 0o600))
+			if tt.etag != "" {
+				require.NoError(t, os.WriteFile(path+".part.etag", []byte(tt.etag), 0o600))
+			}
+
+			n, err := client.DownloadFile(context.Background(), &model.DownloadLink{URL: url, Etag: tt.link}, path, &DownloadOptions{
+				Checksum: checksum(downloadContent),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(downloadContent)), n)
+			assert.Equal(t, tt.ranges, ranges)
+
+			b, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, downloadContent, b)
+		})
+	}
+}
+
+func TestDownloadFile_interruptedStoresETag(t *testing.T) {
+	client, url := setupDownload(t, func(w http.ResponseWriter, _ *http.Request, _ int) bool {
+		interrupt(w)
+		return true
+	})
+
+	path := filepath.Join(t.TempDir(), "file.zip")
+	_, err := client.DownloadFile(context.Background(), &model.DownloadLink{URL: url}, path, &DownloadOptions{MaxResumes: 1})
+	require.Error(t, err)
+	assert.FileExists(t, path+".part")
+
+	etag, err := os.ReadFile(path + ".part.etag")
+	require.NoError(t, err)
+	assert.Equal(t, `"v1"`, string(etag))
+}
+
+func TestDownloadFile_mismatchRemovesPartFile(t *testing.T) {
+	client, url := setupDownload(t, nil)
+
+	path := filepath.Join(t.TempDir(), "file.zip")
+	_, err := client.DownloadFile(context.Background(), &model.DownloadLink{URL: url}, path, &DownloadOptions{Checksum: "00"})
+	require.ErrorIs(t, err, ErrDownloadMismatch)
+	assert.NoFileExists(t, path)
+	assert.NoFileExists(t, path+".part")
+	assert.NoFileExists(t, path+".part.etag")
+}
+
+func TestDownload_emptyLink(t *testing.T) {
+	client, _ := setupDownload(t, nil)
+
+	_, err := client.Download(context.Background(), nil, &bytes.Buffer{}, nil)
+	require.EqualError(t, err, "download link cannot be empty")
+}
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		value       string
+		start, size int64
+		ok          bool
+	}{
+		{"bytes 100-199/200", 100, 200, true},
+		{"bytes 0-99/*", 0, -1, true},
+		{"bytes */200", 0, 200, true},
+		{"items 0-1/2", 0, 0, false},
+		{"bytes abc-1/2", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		start, size, ok := parseContentRange(tt.value)
+		assert.Equal(t, tt.ok, ok, tt.value)
+		assert.Equal(t, tt.start, start, tt.value)
+		assert.Equal(t, tt.size, size, tt.value)
+	}
+}
@@ -0,0 +1,17 @@
+package crowdin
+
+import "github.com/crowdin/crowdin-api-client-go/crowdin/model"
+
+// Sentinel errors matched by the API errors with errors.Is:
+//
+//	if errors.Is(err, crowdin.ErrNotFound) {
+//		// handle missing resource
+//	}
+var (
+	ErrNotFound     = model.ErrNotFound
+	ErrUnauthorized = model.ErrUnauthorized
+	ErrForbidden    = model.ErrForbidden
+	ErrRateLimited  = model.ErrRateLimited
+	ErrConflict     = model.ErrConflict
+	ErrServerError  = model.ErrServerError
+)
@@ -0,0 +1,242 @@
+// Package exportpattern resolves the export patterns of Crowdin files.
+//
+// The export patterns of the files, directories and bundles define the
+// path of the translations in the build archives with placeholders, e.g.
+// "/%two_letters_code%/%original_path%/%original_file_name%". A Resolver
+// replaces them with the codes of a language, overridden by the language
+// mapping of the project, and with the path of the source file:
+//
+//	project, _, err := client.Projects.Get(ctx, projectID)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	r := exportpattern.NewResolver(project.LanguageMapping)
+//
+//	lang, _, err := client.Languages.Get(ctx, "uk")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	p, err := r.Resolve("/%two_letters_code%/%original_file_name%", "/app/strings.json", lang)
+//	// p == "/uk/strings.json"
+//
+// The source placeholders are:
+//
+//   - %original_file_name%: the name of the file, e.g. "strings.json".
+//   - %file_name%: the name of the file without the extension, e.g. "strings".
+//   - %file_extension%: the extension of the file, e.g. "json".
+//   - %original_path%: the directories of the file, e.g. "app/res".
+//
+// The language placeholders are %language%, %two_letters_code%,
+// %three_letters_code%, %locale%, %locale_with_underscore%, %android_code%,
+// %osx_code% and %osx_locale%.
+//
+// The files without an export pattern inherit the one of their closest
+// directory that has one, see Resolver.Directories. The bundles are exported
+// as one file per language and only accept the language placeholders.
+package exportpattern
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+var (
+	// ErrUnknownPlaceholder is returned when a pattern
+	// contains a placeholder that is not supported.
+	ErrUnknownPlaceholder = errors.New("exportpattern: unknown placeholder")
+	// ErrMissingCode is returned when the language
+	// has no code for a placeholder of the pattern.
+	ErrMissingCode = errors.New("exportpattern: missing language code")
+	// ErrNoPattern is returned when the file, its directories
+	// or the bundle have no export pattern.
+	ErrNoPattern = errors.New("exportpattern: no export pattern")
+)
+
+// placeholders matches the placeholders of the patterns, e.g. "%locale%".
+var placeholders = regexp.MustCompile(`%[a-z_]+%`)
+
+// Resolver resolves the export patterns of a project.
+type Resolver struct {
+	// LanguageMapping overrides the codes of the languages by language
+	// identifier, as in the LanguageMapping of the project.
+	LanguageMapping map[string]model.LanguageMapping
+	// Directories are the directories of the project by identifier. The
+	// files without an export pattern inherit the one of the closest of
+	// their directories that has one.
+	Directories map[int]*model.Directory
+}
+
+// NewResolver returns a resolver overriding the codes of the languages
+// with the language mapping of the project.
+func NewResolver(mapping map[string]model.LanguageMapping) *Resolver {
+	return &Resolver{LanguageMapping: mapping}
+}
+
+// Resolve replaces the placeholders of the pattern with the values of the
+// source file and of the language, and returns the cleaned path.
+//
+// The source path is the slash-separated path of the file in the project,
+// e.g. "/app/res/strings.xml". If lang is nil, the language placeholders are
+// left unchanged. The unknown placeholders and the placeholders the language
+// has no code for are left unchanged too, and reported by the error.
+func (r *Resolver) Resolve(pattern, sourcePath string, lang *model.Language) (string, error) {
+	sourcePath = path.Clean("/" + sourcePath)
+	dir, name := path.Split(sourcePath)
+	ext := path.Ext(name)
+
+	var errs []error
+	p := placeholders.ReplaceAllStringFunc(pattern, func(p string) string {
+		switch key := strings.Trim(p, "%"); key {
+		case "original_file_name":
+			return name
+		case "file_name":
+			return strings.TrimSuffix(name, ext)
+		case "file_extension":
+			return strings.TrimPrefix(ext, ".")
+		case "original_path":
+			return strings.Trim(dir, "/")
+		default:
+			if !IsLanguagePlaceholder(p) {
+				errs = append(errs, fmt.Errorf("%w %s", ErrUnknownPlaceholder, p))
+				return p
+			}
+			if lang == nil {
+				return p
+			}
+			code := r.code(key, lang)
+			if code == "" {
+				errs = append(errs, fmt.Errorf("%w: language %q has no %s", ErrMissingCode, lang.ID, key))
+				return p
+			}
+			return code
+		}
+	})
+
+	if p != "" {
+		p = path.Clean(p)
+	}
+	return p, errors.Join(errs...)
+}
+
+// ResolveFile resolves the export pattern of the file for the language,
+// or the one it inherits from its directories. It returns ErrNoPattern
+// if neither the file nor its directories have an export pattern.
+func (r *Resolver) ResolveFile(file *model.File, lang *model.Language) (string, error) {
+	pattern := r.Pattern(file)
+	if pattern == "" {
+		return "", fmt.Errorf("%w for file %d", ErrNoPattern, file.ID)
+	}
+	return r.Resolve(pattern, file.Path, lang)
+}
+
+// Pattern returns the export pattern of the file, or the one of the closest
+// of its Directories that has one, or an empty string if there is none.
+func (r *Resolver) Pattern(file *model.File) string {
+	if pattern := FilePattern(file); pattern != "" {
+		return pattern
+	}
+
+	seen := make(map[int]bool)
+	id := file.DirectoryID
+	for id != nil && !seen[*id] {
+		seen[*id] = true
+		dir, ok := r.Directories[*id]
+		if !ok {
+			break
+		}
+		if dir.ExportPattern != "" {
+			return dir.ExportPattern
+		}
+		id = dir.DirectoryID
+	}
+	return ""
+}
+
+// ResolveBundle resolves the export pattern of the bundle for the language.
+// A bundle is exported as a single file per language, so its pattern can
+// only contain language placeholders; the others are reported with
+// ErrUnknownPlaceholder.
+func (r *Resolver) ResolveBundle(bundle *model.Bundle, lang *model.Language) (string, error) {
+	if bundle.ExportPattern == "" {
+		return "", fmt.Errorf("%w for bundle %d", ErrNoPattern, bundle.ID)
+	}
+
+	var errs []error
+	for _, p := range placeholders.FindAllString(bundle.ExportPattern, -1) {
+		if !IsLanguagePlaceholder(p) {
+			errs = append(errs, fmt.Errorf("%w %s in bundle %d", ErrUnknownPlaceholder, p, bundle.ID))
+		}
+	}
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+	return r.Resolve(bundle.ExportPattern, "", lang)
+}
+
+// FilePattern returns the export pattern of the file,
+// or an empty string if the file has none.
+func FilePattern(file *model.File) string {
+	pattern, _ := file.ExportOptions["exportPattern"].(string)
+	return pattern
+}
+
+// IsLanguagePlaceholder reports whether the placeholder,
+// e.g. "%locale%", is replaced with a code of the languages.
+func IsLanguagePlaceholder(placeholder string) bool {
+	_, ok := languageCode(strings.Trim(placeholder, "%"), &model.Language{}, &model.LanguageMapping{})
+	return ok
+}
+
+// HasLanguagePlaceholder reports whether the pattern
+// contains a placeholder of the languages.
+func HasLanguagePlaceholder(pattern string) bool {
+	for _, p := range placeholders.FindAllString(pattern, -1) {
+		if IsLanguagePlaceholder(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// code returns the code of the language for the placeholder key,
+// overridden by the language mapping.
+func (r *Resolver) code(key string, lang *model.Language) string {
+	mapping := r.LanguageMapping[lang.ID]
+	code, _ := languageCode(key, lang, &mapping)
+	return code
+}
+
+// languageCode returns the code of the mapping for the placeholder key,
+// or the one of the language if the mapping has none.
+func languageCode(key string, lang *model.Language, mapping *model.LanguageMapping) (string, bool) {
+	var code, mapped string
+	switch key {
+	case "language":
+		code, mapped = lang.Name, mapping.Name
+	case "two_letters_code":
+		code, mapped = lang.TwoLettersCode, mapping.TwoLettersCode
+	case "three_letters_code":
+		code, mapped = lang.ThreeLettersCode, mapping.ThreeLettersCode
+	case "locale":
+		code, mapped = lang.Locale, mapping.Locale
+	case "locale_with_underscore":
+		code, mapped = strings.ReplaceAll(lang.Locale, "-", "_"), mapping.LocaleWithUnderscore
+	case "android_code":
+		code, mapped = lang.AndroidCode, mapping.AndroidCode
+	case "osx_code":
+		code, mapped = lang.OSXCode, mapping.OSXCode
+	case "osx_locale":
+		code, mapped = lang.OSXLocale, mapping.OSXLocale
+	default:
+		return "", false
+	}
+	if mapped != "" {
+		return mapped, true
+	}
+	return code, true
+}
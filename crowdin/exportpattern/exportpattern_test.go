@@ -0,0 +1,151 @@
+package exportpattern
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+func ukrainian() *model.Language {
+	return &model.Language{
+		ID:               "uk",
+		Name:             "Ukrainian",
+		TwoLettersCode:   "uk",
+		ThreeLettersCode: "ukr",
+		Locale:           "uk-UA",
+		AndroidCode:      "uk-rUA",
+		OSXCode:          "uk.lproj",
+		OSXLocale:        "uk",
+	}
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	r := NewResolver(map[string]model.LanguageMapping{
+		"uk": {TwoLettersCode: "ua", AndroidCode: "ua-rUA"},
+		"fr": {Locale: "fr"},
+	})
+
+	tests := []struct {
+		pattern string
+		source  string
+		want    string
+	}{
+		{"/%two_letters_code%/%original_path%/%original_file_name%", "/app/res/strings.json", "/ua/app/res/strings.json"},
+		{"/%two_letters_code%/%original_path%/%original_file_name%", "/strings.json", "/ua/strings.json"},
+		{"/values-%android_code%/%file_name%.%file_extension%", "/res/strings.xml", "/values-ua-rUA/strings.xml"},
+		{"%locale%/%locale_with_underscore%/%three_letters_code%", "", "uk-UA/uk_UA/ukr"},
+		{"/%osx_code%/%osx_locale%.strings", "/Localizable.strings", "/uk.lproj/uk.strings"},
+		{"/%language%/%original_file_name%", "app/menu.json", "/Ukrainian/menu.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			got, err := r.Resolve(tt.pattern, tt.source, ukrainian())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolver_Resolve_errors(t *testing.T) {
+	r := NewResolver(nil)
+
+	got, err := r.Resolve("/%android_code%/%unknown%/%original_file_name%", "/a.json", &model.Language{ID: "fr"})
+	require.ErrorIs(t, err, ErrMissingCode)
+	require.ErrorIs(t, err, ErrUnknownPlaceholder)
+	assert.EqualError(t, err, `exportpattern: missing language code: language "fr" has no android_code
+exportpattern: unknown placeholder %unknown%`)
+	assert.Equal(t, "/%android_code%/%unknown%/a.json", got)
+}
+
+func TestResolver_Resolve_noLanguage(t *testing.T) {
+	got, err := NewResolver(nil).Resolve("/%locale%/%file_name%.po", "/app/messages.pot", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "/%locale%/messages.po", got)
+}
+
+func TestResolver_ResolveFile(t *testing.T) {
+	r := NewResolver(nil)
+
+	file := &model.File{
+		ID:            5,
+		Path:          "/app/strings.xml",
+		ExportOptions: map[string]any{"exportPattern": "/values-%android_code%/%original_file_name%"},
+	}
+	got, err := r.ResolveFile(file, ukrainian())
+	require.NoError(t, err)
+	assert.Equal(t, "/values-uk-rUA/strings.xml", got)
+
+	_, err = r.ResolveFile(&model.File{ID: 6, Path: "/app/a.json"}, ukrainian())
+	require.ErrorIs(t, err, ErrNoPattern)
+	assert.EqualError(t, err, "exportpattern: no export pattern for file 6")
+}
+
+func TestResolver_ResolveFile_directories(t *testing.T) {
+	r := NewResolver(nil)
+	r.Directories = map[int]*model.Directory{
+		1: {ID: 1, Name: "app", ExportPattern: "/%two_letters_code%/%original_path%/%original_file_name%"},
+		2: {ID: 2, Name: "res", DirectoryID: ptr(1)},
+		3: {ID: 3, Name: "android", DirectoryID: ptr(2), ExportPattern: "/values-%android_code%/%original_file_name%"},
+		4: {ID: 4, Name: "docs"},
+		5: {ID: 5, Name: "loop", DirectoryID: ptr(6)},
+		6: {ID: 6, Name: "loop", DirectoryID: ptr(5)},
+	}
+
+	tests := []struct {
+		name string
+		file *model.File
+		want string
+	}{
+		{"parent", &model.File{ID: 1, Path: "/app/res/menu.json", DirectoryID: ptr(2)}, "/uk/app/res/menu.json"},
+		{"closest", &model.File{ID: 2, Path: "/app/res/android/strings.xml", DirectoryID: ptr(3)}, "/values-uk-rUA/strings.xml"},
+		{"file overrides", &model.File{
+			ID: 3, Path: "/app/res/a.json", DirectoryID: ptr(2),
+			ExportOptions: map[string]any{"exportPattern": "/%locale%.json"},
+		}, "/uk-UA.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.ResolveFile(tt.file, ukrainian())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, dirID := range []int{4, 5, 99} {
+		_, err := r.ResolveFile(&model.File{ID: 7, Path: "/docs/a.md", DirectoryID: ptr(dirID)}, ukrainian())
+		require.ErrorIs(t, err, ErrNoPattern, dirID)
+	}
+}
+
+func TestResolver_ResolveBundle(t *testing.T) {
+	r := NewResolver(map[string]model.LanguageMapping{"uk": {Locale: "uk"}})
+
+	got, err := r.ResolveBundle(&model.Bundle{ID: 1, ExportPattern: "/bundles/strings-%locale%.resx"}, ukrainian())
+	require.NoError(t, err)
+	assert.Equal(t, "/bundles/strings-uk.resx", got)
+
+	_, err = r.ResolveBundle(&model.Bundle{ID: 2, ExportPattern: "/%locale%/%original_file_name%"}, ukrainian())
+	require.ErrorIs(t, err, ErrUnknownPlaceholder)
+	assert.EqualError(t, err, "exportpattern: unknown placeholder %original_file_name% in bundle 2")
+
+	_, err = r.ResolveBundle(&model.Bundle{ID: 3}, ukrainian())
+	require.ErrorIs(t, err, ErrNoPattern)
+}
+
+func ptr(v int) *int {
+	return &v
+}
+
+func TestHasLanguagePlaceholder(t *testing.T) {
+	assert.True(t, HasLanguagePlaceholder("/%osx_locale%/%original_file_name%"))
+	assert.False(t, HasLanguagePlaceholder("/%original_path%/%original_file_name%"))
+	assert.False(t, HasLanguagePlaceholder("/locale/strings.json"))
+
+	assert.True(t, IsLanguagePlaceholder("%locale%"))
+	assert.False(t, IsLanguagePlaceholder("%file_name%"))
+}
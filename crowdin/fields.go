@@ -17,9 +17,10 @@ type FieldsService struct {
 // List returns a list of fields.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.fields.getMany
-func (s *FieldsService) List(ctx context.Context, opts *model.FieldsListOptions) ([]*model.Field, *Response, error) {
+func (s *FieldsService) List(ctx context.Context, opts *model.FieldsListOptions, reqOpts ...RequestOption) ([]*model.Field, *Response, error) {
+	ctx = withOperation(ctx, "FieldsService.List")
 	res := new(model.FieldsListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/fields", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/fields", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -35,9 +36,10 @@ func (s *FieldsService) List(ctx context.Context, opts *model.FieldsListOptions)
 // Get returns a field by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.fields.get
-func (s *FieldsService) Get(ctx context.Context, fieldID int) (*model.Field, *Response, error) {
+func (s *FieldsService) Get(ctx context.Context, fieldID int, reqOpts ...RequestOption) (*model.Field, *Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Get")
 	res := new(model.FieldResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/fields/%d", fieldID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/fields/%d", fieldID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -45,9 +47,10 @@ func (s *FieldsService) Get(ctx context.Context, fieldID int) (*model.Field, *Re
 // Add creates a new field.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.fields.post
-func (s *FieldsService) Add(ctx context.Context, req *model.FieldAddRequest) (*model.Field, *Response, error) {
+func (s *FieldsService) Add(ctx context.Context, req *model.FieldAddRequest, reqOpts ...RequestOption) (*model.Field, *Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Add")
 	res := new(model.FieldResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/fields", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/fields", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -60,9 +63,10 @@ func (s *FieldsService) Add(ctx context.Context, req *model.FieldAddRequest) (*m
 //   - Value (string): new value to set.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.fields.patch
-func (s *FieldsService) Edit(ctx context.Context, fieldID int, req []*model.UpdateRequest) (*model.Field, *Response, error) {
+func (s *FieldsService) Edit(ctx context.Context, fieldID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.Field, *Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Edit")
 	res := new(model.FieldResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/fields/%d", fieldID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/fields/%d", fieldID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -70,6 +74,7 @@ func (s *FieldsService) Edit(ctx context.Context, fieldID int, req []*model.Upda
 // Delete deletes a field.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.fields.delete
-func (s *FieldsService) Delete(ctx context.Context, fieldID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/fields/%d", fieldID), nil)
+func (s *FieldsService) Delete(ctx context.Context, fieldID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/fields/%d", fieldID), nil, reqOpts...)
 }
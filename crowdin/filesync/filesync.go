@@ -0,0 +1,542 @@
+// Package filesync mirrors a tree of local source files to a Crowdin project.
+//
+// A Syncer compares the files of a file system with the directories and files
+// of the project (or of one of its branches) and computes a plan of the actions
+// to take: create the missing directories and files, update the changed files,
+// delete the files removed locally, and skip the unchanged ones. The plan can
+// be printed as a dry-run and applied concurrently:
+//
+//	s := filesync.New(client, projectID, os.DirFS("locales/en"), &filesync.Options{
+//		Root:   "app",
+//		Delete: true,
+//		State:  state,
+//	})
+//
+//	plan, err := s.Plan(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	plan.WriteTo(os.Stdout)
+//
+//	res, err := s.Apply(ctx, plan)
+//	for _, e := range res.Errors {
+//		log.Printf("%s %s: %v", e.Item.Action, e.Item.Path, e.Err)
+//	}
+//
+// Crowdin does not expose the content hash of the files, so unchanged files
+// are detected with the State of the previous runs, which records the hash of
+// the content pushed and the revision of the remote file.
+package filesync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// Action is an action of a plan.
+type Action string
+
+// Actions of a plan.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionSkip   Action = "skip"
+)
+
+// Options specifies the optional parameters of a Syncer.
+type Options struct {
+	// BranchID is the identifier of the branch the files are synced to.
+	// Zero value syncs the files outside of the branches.
+	BranchID int
+	// Root is the slash-separated path of the project directory the file
+	// system is mirrored to, e.g. "app/src". Defaults to the project root.
+	Root string
+	// Match reports whether the local file at the slash-separated path
+	// relative to the root of the file system is synced. Remote files not
+	// matched are never deleted. Defaults to all the files.
+	Match func(path string) bool
+	// Delete enables the deletion of the remote files under the root
+	// that do not exist locally.
+	Delete bool
+	// State is the state of the previous runs used to skip unchanged
+	// files. It is updated by Apply and should be persisted between runs.
+	// If nil, all the existing files are updated.
+	State State
+	// UpdateOption defines how the translations are handled when a file is
+	// updated, e.g. "keep_translations". See model.FileUpdateRestoreRequest.
+	UpdateOption string
+	// Concurrency is the number of files applied concurrently. Defaults to 4.
+	Concurrency int
+	// Progress is called after every item of the plan is applied.
+	Progress func(item *Item, err error)
+}
+
+// State records the files pushed by the syncer keyed by their path in the project.
+// It can be persisted with encoding/json.
+type State map[string]FileState
+
+// FileState is the state of a file pushed by the syncer.
+type FileState struct {
+	// Hash is the hex-encoded SHA-256 hash of the content pushed.
+	Hash string `json:"hash"`
+	// RevisionID is the revision of the remote file created by the push.
+	RevisionID int `json:"revisionId"`
+}
+
+// Item is an action on a file or a directory.
+type Item struct {
+	Action Action
+	// Path is the slash-separated path of the file or the directory
+	// in the project or the branch, e.g. "app/src/strings.json".
+	Path string
+	// Local is the path of the file in the file system.
+	// Empty for directories and deleted files.
+	Local string
+	// Dir reports whether the item is a directory.
+	Dir bool
+	// ID is the identifier of the remote file, zero if it does not exist.
+	ID int
+	// RevisionID is the revision of the remote file.
+	RevisionID int
+	// Hash is the hex-encoded SHA-256 hash of the local file.
+	Hash string
+}
+
+// Plan is the list of actions syncing the file system to the project.
+// Directories are created first, parents before children.
+type Plan struct {
+	Items []*Item
+
+	// dirs maps the paths of the remote directories to their identifiers.
+	dirs map[string]int
+}
+
+// Count returns the number of items with the action.
+func (p *Plan) Count(action Action) int {
+	n := 0
+	for _, item := range p.Items {
+		if item.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteTo writes the plan to w, one item per line. It implements io.WriterTo.
+func (p *Plan) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for _, item := range p.Items {
+		name := item.Path
+		if item.Dir {
+			name += "/"
+		}
+		n, err := fmt.Fprintf(w, "%-6s %s\n", item.Action, name)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ItemError is the error of an item that could not be applied.
+type ItemError struct {
+	Item *Item
+	Err  error
+}
+
+// Error implements the Error interface.
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("filesync: cannot %s %s: %v", e.Item.Action, e.Item.Path, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ItemError) Unwrap() error {
+	return e.Err
+}
+
+// Result is the result of applying a plan.
+type Result struct {
+	// Applied lists the items applied successfully, skipped items included.
+	Applied []*Item
+	// Errors lists the items that could not be applied.
+	Errors []*ItemError
+}
+
+// Syncer syncs a file system to a Crowdin project.
+type Syncer struct {
+	client    *crowdin.Client
+	projectID int
+	fsys      fs.FS
+	opts      Options
+	root      string
+
+	mu sync.Mutex // guards opts.State
+}
+
+// New returns a Syncer of the file system to the project.
+func New(client *crowdin.Client, projectID int, fsys fs.FS, opts *Options) *Syncer {
+	s := &Syncer{client: client, projectID: projectID, fsys: fsys}
+	if opts != nil {
+		s.opts = *opts
+	}
+	if s.opts.Concurrency <= 0 {
+		s.opts.Concurrency = 4
+	}
+	if s.opts.Match == nil {
+		s.opts.Match = func(string) bool { return true }
+	}
+	s.root = strings.Trim(path.Clean("/"+s.opts.Root), "/")
+	return s
+}
+
+// Plan compares the file system with the project and returns the plan of
+// the actions syncing them. Nothing is changed in the project, so the plan
+// can be used as a dry-run.
+func (s *Syncer) Plan(ctx context.Context) (*Plan, error) {
+	dirs, files, err := s.remote(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{dirs: make(map[string]int, len(dirs))}
+	for p, dir := range dirs {
+		plan.dirs[p] = dir.ID
+	}
+
+	local := make(map[string]bool)
+	newDirs := make(map[string]bool)
+	var items []*Item
+
+	err = fs.WalkDir(s.fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() || !s.opts.Match(name) {
+			return nil
+		}
+
+		hash, err := s.hash(name)
+		if err != nil {
+			return err
+		}
+
+		item := &Item{Path: s.remotePath(name), Local: name, Hash: hash}
+		local[item.Path] = true
+		for dir := path.Dir(item.Path); dir != "."; dir = path.Dir(dir) {
+			if _, ok := dirs[dir]; !ok {
+				newDirs[dir] = true
+			}
+		}
+
+		file, ok := files[item.Path]
+		switch {
+		case !ok:
+			item.Action = ActionCreate
+		case s.unchanged(item.Path, hash, file.RevisionID):
+			item.Action = ActionSkip
+		default:
+			item.Action = ActionUpdate
+		}
+		if ok {
+			item.ID, item.RevisionID = file.ID, file.RevisionID
+		}
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.opts.Delete {
+		for p, file := range files {
+			rel, ok := s.localPath(p)
+			if ok && !local[p] && s.opts.Match(rel) {
+				items = append(items, &Item{Action: ActionDelete, Path: p, ID: file.ID, RevisionID: file.RevisionID})
+			}
+		}
+	}
+
+	for dir := range newDirs {
+		plan.Items = append(plan.Items, &Item{Action: ActionCreate, Path: dir, Dir: true})
+	}
+	sort.Slice(plan.Items, func(i, j int) bool { return plan.Items[i].Path < plan.Items[j].Path })
+	sort.Slice(items, func(i, j int) bool { return items[i].Path < items[j].Path })
+	plan.Items = append(plan.Items, items...)
+
+	return plan, nil
+}
+
+// Apply applies the plan to the project. The directories are created
+// first, then the files are created, updated and deleted concurrently.
+// The items that cannot be applied are reported in the result, and the
+// returned error joins their errors.
+func (s *Syncer) Apply(ctx context.Context, plan *Plan) (*Result, error) {
+	res := &Result{}
+	var mu sync.Mutex
+	report := func(item *Item, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			res.Errors = append(res.Errors, &ItemError{Item: item, Err: err})
+		} else {
+			res.Applied = append(res.Applied, item)
+		}
+		if s.opts.Progress != nil {
+			s.opts.Progress(item, err)
+		}
+	}
+
+	dirs := make(map[string]int, len(plan.dirs))
+	for p, id := range plan.dirs {
+		dirs[p] = id
+	}
+
+	var files []*Item
+	for _, item := range plan.Items {
+		if !item.Dir {
+			files = append(files, item)
+			continue
+		}
+		id, err := s.createDir(ctx, item, dirs)
+		if err == nil {
+			dirs[item.Path] = id
+		}
+		report(item, err)
+	}
+
+	sem := make(chan struct{}, s.opts.Concurrency)
+	var wg sync.WaitGroup
+	for _, item := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			report(item, s.apply(ctx, item, dirs))
+		}()
+	}
+	wg.Wait()
+
+	errs := make([]error, 0, len(res.Errors))
+	for _, e := range res.Errors {
+		errs = append(errs, e)
+	}
+	return res, errors.Join(errs...)
+}
+
+// apply applies the action of the file item.
+func (s *Syncer) apply(ctx context.Context, item *Item, dirs map[string]int) error {
+	switch item.Action {
+	case ActionCreate:
+		req := &model.FileAddRequest{Name: path.Base(item.Path)}
+		if parent := path.Dir(item.Path); parent != "." {
+			if req.DirectoryID = dirs[parent]; req.DirectoryID == 0 {
+				return fmt.Errorf("directory %s has not been created", parent)
+			}
+		} else {
+			req.BranchID = s.opts.BranchID
+		}
+
+		file, err := s.push(ctx, item, func(f fs.File) (*model.File, error) {
+			file, _, err := s.client.SourceFiles.AddFileFromReader(ctx, s.projectID, req.Name, f, req)
+			return file, err
+		})
+		if err == nil {
+			item.ID = file.ID
+		}
+		return err
+	case ActionUpdate:
+		req := &model.FileUpdateRestoreRequest{UpdateOption: s.opts.UpdateOption}
+		_, err := s.push(ctx, item, func(f fs.File) (*model.File, error) {
+			file, _, err := s.client.SourceFiles.UpdateFileFromReader(ctx, s.projectID, item.ID, path.Base(item.Path), f, req)
+			return file, err
+		})
+		return err
+	case ActionDelete:
+		if _, err := s.client.SourceFiles.DeleteFile(ctx, s.projectID, item.ID); err != nil {
+			return err
+		}
+		s.mu.Lock()
+		delete(s.opts.State, item.Path)
+		s.mu.Unlock()
+		return nil
+	default:
+		return nil
+	}
+}
+
+// push sends the content of the local file of the item
+// and records the pushed revision in the state.
+func (s *Syncer) push(ctx context.Context, item *Item, send func(f fs.File) (*model.File, error)) (*model.File, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	f, err := s.fsys.Open(item.Local)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	file, err := send(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.opts.State != nil {
+		s.mu.Lock()
+		s.opts.State[item.Path] = FileState{Hash: item.Hash, RevisionID: file.RevisionID}
+		s.mu.Unlock()
+	}
+	return file, nil
+}
+
+// createDir creates the directory of the item and returns its identifier.
+func (s *Syncer) createDir(ctx context.Context, item *Item, dirs map[string]int) (int, error) {
+	req := &model.DirectoryAddRequest{Name: path.Base(item.Path)}
+	if parent := path.Dir(item.Path); parent != "." {
+		if req.DirectoryID = dirs[parent]; req.DirectoryID == 0 {
+			return 0, fmt.Errorf("directory %s has not been created", parent)
+		}
+	} else {
+		req.BranchID = s.opts.BranchID
+	}
+
+	dir, _, err := s.client.SourceFiles.AddDirectory(ctx, s.projectID, req)
+	if err != nil {
+		return 0, err
+	}
+	item.ID = dir.ID
+	return dir.ID, nil
+}
+
+// remote returns the directories and the files of the project
+// or of the branch keyed by their path.
+func (s *Syncer) remote(ctx context.Context) (map[string]*model.Directory, map[string]*model.File, error) {
+	// Without recursion, only the top level of the branch is listed.
+	dirOpts := &model.DirectoryListOptions{BranchID: s.opts.BranchID}
+	fileOpts := &model.FileListOptions{BranchID: s.opts.BranchID}
+	if s.opts.BranchID != 0 {
+		dirOpts.Recursion = "1"
+		fileOpts.Recursion = "1"
+	}
+
+	byID := make(map[int]*model.Directory)
+	for dir, err := range s.client.SourceFiles.ListAllDirectories(ctx, s.projectID, dirOpts) {
+		if err != nil {
+			return nil, nil, err
+		}
+		byID[dir.ID] = dir
+	}
+
+	// The paths of the directories of the branch, empty for the others.
+	dirPaths := make(map[int]string, len(byID))
+	var dirPath func(id int) string
+	dirPath = func(id int) string {
+		if p, ok := dirPaths[id]; ok {
+			return p
+		}
+		dirPaths[id] = "" // guards against cycles
+
+		var p string
+		if dir, ok := byID[id]; ok {
+			switch {
+			case dir.DirectoryID == nil && s.inBranch(dir.BranchID):
+				p = dir.Name
+			case dir.DirectoryID != nil && (dir.BranchID == nil || s.inBranch(dir.BranchID)):
+				if parent := dirPath(*dir.DirectoryID); parent != "" {
+					p = parent + "/" + dir.Name
+				}
+			}
+		}
+		dirPaths[id] = p
+		return p
+	}
+
+	dirs := make(map[string]*model.Directory, len(byID))
+	for id, dir := range byID {
+		if p := dirPath(id); p != "" {
+			dirs[p] = dir
+		}
+	}
+
+	files := make(map[string]*model.File)
+	for file, err := range s.client.SourceFiles.ListAllFiles(ctx, s.projectID, fileOpts) {
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case file.DirectoryID == nil && s.inBranch(file.BranchID):
+			files[file.Name] = file
+		case file.DirectoryID != nil && (file.BranchID == nil || s.inBranch(file.BranchID)):
+			if dir := dirPath(*file.DirectoryID); dir != "" {
+				files[dir+"/"+file.Name] = file
+			}
+		}
+	}
+
+	return dirs, files, nil
+}
+
+// inBranch reports whether the remote items of the branch are synced.
+// Nested items may have no branch, they belong to the branch of their
+// parent directory.
+func (s *Syncer) inBranch(branchID *int) bool {
+	if s.opts.BranchID == 0 {
+		return branchID == nil || *branchID == 0
+	}
+	return branchID != nil && *branchID == s.opts.BranchID
+}
+
+// remotePath returns the path in the project of the local file.
+func (s *Syncer) remotePath(name string) string {
+	if s.root == "" {
+		return name
+	}
+	return s.root + "/" + name
+}
+
+// localPath returns the path in the file system of the remote file
+// and reports whether it is under the root.
+func (s *Syncer) localPath(p string) (string, bool) {
+	if s.root == "" {
+		return p, true
+	}
+	return strings.CutPrefix(p, s.root+"/")
+}
+
+// unchanged reports whether the file has not changed since it was pushed.
+func (s *Syncer) unchanged(p, hash string, revisionID int) bool {
+	state, ok := s.opts.State[p]
+	return ok && state.Hash == hash && state.RevisionID == revisionID
+}
+
+func (s *Syncer) hash(name string) (string, error) {
+	f, err := s.fsys.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,328 @@
+package filesync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// project is a fake Crowdin project serving the source files API.
+type project struct {
+	t *testing.T
+
+	mu       sync.Mutex
+	dirs     []*model.Directory
+	files    []*model.File
+	storages map[int]string
+	nextID   int
+	requests []string
+	fail     map[string]bool // names of the files whose creation fails
+}
+
+func setup(t *testing.T, p *project) *crowdin.Client {
+	t.Helper()
+
+	p.t = t
+	p.storages = make(map[int]string)
+	p.nextID = 100
+
+	server := httptest.NewServer(p)
+	t.Cleanup(server.Close)
+
+	client, err := crowdin.NewClient("token", crowdin.WithBaseURL(server.URL))
+	require.NoError(t, err)
+	return client
+}
+
+func (p *project) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	body, err := io.ReadAll(r.Body)
+	require.NoError(p.t, err)
+
+	route := r.Method + " " + r.URL.Path
+	switch {
+	case route == "GET /api/v2/projects/1/directories":
+		var dirs []*model.Directory
+		for _, dir := range p.dirs {
+			if p.listed(r, dir.BranchID, dir.DirectoryID) {
+				dirs = append(dirs, dir)
+			}
+		}
+		p.list(w, r, dirs)
+		return
+	case route == "GET /api/v2/projects/1/files":
+		var files []*model.File
+		for _, file := range p.files {
+			if p.listed(r, file.BranchID, file.DirectoryID) {
+				files = append(files, file)
+			}
+		}
+		p.list(w, r, files)
+		return
+	case route == "POST /api/v2/storages":
+		p.nextID++
+		p.storages[p.nextID] = string(body)
+		fmt.Fprintf(w, `{"data": {"id": %d}}`, p.nextID)
+		return
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/storages/"):
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	p.requests = append(p.requests, route+" "+string(bytes.TrimSpace(body)))
+	switch {
+	case route == "POST /api/v2/projects/1/directories":
+		var req model.DirectoryAddRequest
+		require.NoError(p.t, json.Unmarshal(body, &req))
+		p.nextID++
+		writeData(w, &model.Directory{ID: p.nextID, Name: req.Name})
+	case route == "POST /api/v2/projects/1/files":
+		var req model.FileAddRequest
+		require.NoError(p.t, json.Unmarshal(body, &req))
+		if p.fail[req.Name] {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"error": {"code": 409, "message": "File already exists"}}`)
+			return
+		}
+		p.nextID++
+		writeData(w, &model.File{ID: p.nextID, Name: req.Name, RevisionID: 1})
+	case r.Method == http.MethodPut:
+		writeData(w, &model.File{RevisionID: 7})
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		p.t.Errorf("unexpected request: %s", route)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// listed reports whether the item in the branch and the directory is
+// listed by the request. Like the API, the branchId parameter lists the
+// top level of the branch, and all its levels with the recursion parameter.
+func (p *project) listed(r *http.Request, branchID, directoryID *int) bool {
+	query := r.URL.Query()
+	if !query.Has("branchId") {
+		return true
+	}
+	if directoryID != nil && !query.Has("recursion") {
+		return false
+	}
+	for directoryID != nil {
+		i := slices.IndexFunc(p.dirs, func(dir *model.Directory) bool { return dir.ID == *directoryID })
+		require.GreaterOrEqual(p.t, i, 0, "unknown directory %d", *directoryID)
+		branchID, directoryID = p.dirs[i].BranchID, p.dirs[i].DirectoryID
+	}
+	return branchID != nil && strconv.Itoa(*branchID) == query.Get("branchId")
+}
+
+func (p *project) list(w http.ResponseWriter, r *http.Request, items any) {
+	if offset := r.URL.Query().Get("offset"); offset != "" && offset != "0" {
+		fmt.Fprint(w, `{"data": []}`)
+		return
+	}
+	var data []map[string]any
+	b, err := json.Marshal(items)
+	require.NoError(p.t, err)
+	var raw []json.RawMessage
+	require.NoError(p.t, json.Unmarshal(b, &raw))
+	for _, item := range raw {
+		data = append(data, map[string]any{"data": item})
+	}
+	require.NoError(p.t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+}
+
+func (p *project) sortedRequests() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	requests := make([]string, 0, len(p.requests))
+	for _, r := range p.requests {
+		requests = append(requests, storageIDs.ReplaceAllString(r, `"storageId":"*"`))
+	}
+	sort.Strings(requests)
+	return requests
+}
+
+func writeData(w http.ResponseWriter, v any) {
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": v})
+}
+
+func sum(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+func newProject() *project {
+	return &project{
+		dirs: []*model.Directory{
+			{ID: 1, Name: "app"},
+			{ID: 2, Name: "res", DirectoryID: crowdin.ToPtr(1)},
+			{ID: 3, Name: "app", BranchID: crowdin.ToPtr(5)},
+			{ID: 4, Name: "res", DirectoryID: crowdin.ToPtr(3)},
+		},
+		files: []*model.File{
+			{ID: 10, Name: "strings.json", DirectoryID: crowdin.ToPtr(1), RevisionID: 3},
+			{ID: 11, Name: "menu.json", DirectoryID: crowdin.ToPtr(1), RevisionID: 2},
+			{ID: 12, Name: "old.json", DirectoryID: crowdin.ToPtr(2), RevisionID: 1},
+			{ID: 13, Name: "README.md", RevisionID: 1},
+			{ID: 14, Name: "strings.json", DirectoryID: crowdin.ToPtr(3), BranchID: crowdin.ToPtr(5), RevisionID: 1},
+			{ID: 15, Name: "colors.json", DirectoryID: crowdin.ToPtr(4), RevisionID: 1},
+		},
+	}
+}
+
+func localFiles() fstest.MapFS {
+	return fstest.MapFS{
+		"strings.json":        {Data: []byte(`{"hello": "Hello"}`)},
+		"menu.json":           {Data: []byte(`{"menu": "Menu"}`)},
+		"about.json":          {Data: []byte(`{"about": "About"}`)},
+		"screens/home.json":   {Data: []byte(`{"home": "Home"}`)},
+		"screens/ignored.txt": {Data: []byte("ignored")},
+	}
+}
+
+func TestSyncer_Plan(t *testing.T) {
+	client := setup(t, newProject())
+
+	s := New(client, 1, localFiles(), &Options{
+		Root:   "/app/",
+		Delete: true,
+		Match:  func(p string) bool { return !strings.HasSuffix(p, ".txt") },
+		State: State{
+			"app/strings.json": {Hash: sum(`{"hello": "Hello"}`), RevisionID: 3},
+			"app/menu.json":    {Hash: sum(`{"menu": "Old"}`), RevisionID: 2},
+		},
+	})
+
+	plan, err := s.Plan(context.Background())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = plan.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"create app/screens/",
+		"create app/about.json",
+		"update app/menu.json",
+		"delete app/res/old.json",
+		"create app/screens/home.json",
+		"skip   app/strings.json",
+		"",
+	}, "\n"), buf.String())
+
+	assert.Equal(t, 3, plan.Count(ActionCreate))
+	assert.Equal(t, 1, plan.Count(ActionSkip))
+	assert.Equal(t, 11, plan.Items[2].ID)
+}
+
+func TestSyncer_Plan_noState(t *testing.T) {
+	client := setup(t, newProject())
+
+	plan, err := New(client, 1, localFiles(), &Options{Root: "app"}).Plan(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, plan.Count(ActionUpdate))
+	assert.Equal(t, 0, plan.Count(ActionSkip))
+	assert.Equal(t, 0, plan.Count(ActionDelete))
+}
+
+func TestSyncer_Apply(t *testing.T) {
+	p := newProject()
+	p.fail = map[string]bool{"about.json": true}
+	client := setup(t, p)
+
+	state := State{}
+	var progress []string
+	s := New(client, 1, localFiles(), &Options{
+		Root:         "app",
+		Delete:       true,
+		State:        state,
+		UpdateOption: "keep_translations",
+		Match:        func(p string) bool { return !strings.HasSuffix(p, ".txt") },
+		Progress: func(item *Item, err error) {
+			progress = append(progress, fmt.Sprintf("%s %s %t", item.Action, item.Path, err == nil))
+		},
+	})
+
+	plan, err := s.Plan(context.Background())
+	require.NoError(t, err)
+
+	res, err := s.Apply(context.Background(), plan)
+	require.Error(t, err)
+	require.ErrorIs(t, err, crowdin.ErrConflict)
+
+	require.Len(t, res.Errors, 1)
+	assert.Equal(t, "app/about.json", res.Errors[0].Item.Path)
+	assert.EqualError(t, res.Errors[0], "filesync: cannot create app/about.json: 409 File already exists")
+	assert.Len(t, res.Applied, 5)
+	assert.Len(t, progress, 6)
+	assert.Equal(t, "create app/screens true", progress[0])
+
+	assert.Equal(t, []string{
+		`DELETE /api/v2/projects/1/files/12 `,
+		`POST /api/v2/projects/1/directories {"name":"screens","directoryId":1}`,
+		`POST /api/v2/projects/1/files {"storageId":"*","name":"about.json","directoryId":1}`,
+		`POST /api/v2/projects/1/files {"storageId":"*","name":"home.json","directoryId":101}`,
+		`PUT /api/v2/projects/1/files/10 {"storageId":"*","updateOption":"keep_translations"}`,
+		`PUT /api/v2/projects/1/files/11 {"storageId":"*","updateOption":"keep_translations"}`,
+	}, p.sortedRequests())
+
+	assert.Equal(t, State{
+		"app/menu.json":         {Hash: sum(`{"menu": "Menu"}`), RevisionID: 7},
+		"app/strings.json":      {Hash: sum(`{"hello": "Hello"}`), RevisionID: 7},
+		"app/screens/home.json": {Hash: sum(`{"home": "Home"}`), RevisionID: 1},
+	}, state)
+}
+
+func TestSyncer_Apply_branch(t *testing.T) {
+	p := newProject()
+	client := setup(t, p)
+
+	s := New(client, 1, fstest.MapFS{
+		"app/strings.json":    {Data: []byte("{}")},
+		"app/res/colors.json": {Data: []byte("{}")},
+		"index.json":          {Data: []byte("{}")},
+	}, &Options{BranchID: 5, Concurrency: 1})
+
+	plan, err := s.Plan(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.Items, 3)
+	assert.Equal(t, ActionUpdate, plan.Items[0].Action)
+	assert.Equal(t, 15, plan.Items[0].ID)
+	assert.Equal(t, ActionUpdate, plan.Items[1].Action)
+	assert.Equal(t, 14, plan.Items[1].ID)
+
+	_, err = s.Apply(context.Background(), plan)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`POST /api/v2/projects/1/files {"storageId":"*","name":"index.json","branchId":5}`,
+		`PUT /api/v2/projects/1/files/14 {"storageId":"*"}`,
+		`PUT /api/v2/projects/1/files/15 {"storageId":"*"}`,
+	}, p.sortedRequests())
+}
+
+// storageIDs matches the storage identifiers, which depend
+// on the order of the concurrent uploads.
+var storageIDs = regexp.MustCompile(`"storageId":\d+`)
@@ -3,6 +3,8 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"io"
+	"strconv"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
 )
@@ -21,11 +23,12 @@ type GlossariesService struct {
 // GetConcept returns a specific concept from a glossary by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.concepts.get
-func (s *GlossariesService) GetConcept(ctx context.Context, glossaryID, conceptID int) (
+func (s *GlossariesService) GetConcept(ctx context.Context, glossaryID, conceptID int, reqOpts ...RequestOption) (
 	*model.Concept, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.GetConcept")
 	res := new(model.ConceptResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -33,11 +36,12 @@ func (s *GlossariesService) GetConcept(ctx context.Context, glossaryID, conceptI
 // ListConcepts returns a list of concepts from a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.concepts.getMany
-func (s *GlossariesService) ListConcepts(ctx context.Context, glossaryID int, opts *model.ConceptsListOptions) (
+func (s *GlossariesService) ListConcepts(ctx context.Context, glossaryID int, opts *model.ConceptsListOptions, reqOpts ...RequestOption) (
 	[]*model.Concept, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.ListConcepts")
 	res := new(model.ConceptsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts", glossaryID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts", glossaryID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -53,11 +57,12 @@ func (s *GlossariesService) ListConcepts(ctx context.Context, glossaryID int, op
 // UpdateConcept updates a specific concept in a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.concepts.put
-func (s *GlossariesService) UpdateConcept(ctx context.Context, glossaryID, conceptID int, req *model.ConceptUpdateRequest) (
+func (s *GlossariesService) UpdateConcept(ctx context.Context, glossaryID, conceptID int, req *model.ConceptUpdateRequest, reqOpts ...RequestOption) (
 	*model.Concept, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.UpdateConcept")
 	res := new(model.ConceptResponse)
-	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID), req, res)
+	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -65,16 +70,18 @@ func (s *GlossariesService) UpdateConcept(ctx context.Context, glossaryID, conce
 // DeleteConcept deletes a specific concept from a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.concepts.delete
-func (s *GlossariesService) DeleteConcept(ctx context.Context, glossaryID, conceptID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID), nil)
+func (s *GlossariesService) DeleteConcept(ctx context.Context, glossaryID, conceptID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "GlossariesService.DeleteConcept")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d/concepts/%d", glossaryID, conceptID), nil, reqOpts...)
 }
 
 // GetGlossary returns a specific glossary by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.get
-func (s *GlossariesService) GetGlossary(ctx context.Context, glossaryID int) (*model.Glossary, *Response, error) {
+func (s *GlossariesService) GetGlossary(ctx context.Context, glossaryID int, reqOpts ...RequestOption) (*model.Glossary, *Response, error) {
+	ctx = withOperation(ctx, "GlossariesService.GetGlossary")
 	res := new(model.GlossaryResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -82,11 +89,12 @@ func (s *GlossariesService) GetGlossary(ctx context.Context, glossaryID int) (*m
 // ListGlossaries returns a list of glossaries.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.getMany
-func (s *GlossariesService) ListGlossaries(ctx context.Context, opts *model.GlossariesListOptions) (
+func (s *GlossariesService) ListGlossaries(ctx context.Context, opts *model.GlossariesListOptions, reqOpts ...RequestOption) (
 	[]*model.Glossary, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.ListGlossaries")
 	res := new(model.GlossariesListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/glossaries", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/glossaries", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -102,11 +110,12 @@ func (s *GlossariesService) ListGlossaries(ctx context.Context, opts *model.Glos
 // AddGlossary creates a new glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.post
-func (s *GlossariesService) AddGlossary(ctx context.Context, req *model.GlossaryAddRequest) (
+func (s *GlossariesService) AddGlossary(ctx context.Context, req *model.GlossaryAddRequest, reqOpts ...RequestOption) (
 	*model.Glossary, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.AddGlossary")
 	res := new(model.GlossaryResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/glossaries", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/glossaries", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -114,11 +123,12 @@ func (s *GlossariesService) AddGlossary(ctx context.Context, req *model.Glossary
 // EditGlossary updates a specific glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.patch
-func (s *GlossariesService) EditGlossary(ctx context.Context, glossaryID int, req []*model.UpdateRequest) (
+func (s *GlossariesService) EditGlossary(ctx context.Context, glossaryID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Glossary, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.EditGlossary")
 	res := new(model.GlossaryResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -126,19 +136,21 @@ func (s *GlossariesService) EditGlossary(ctx context.Context, glossaryID int, re
 // DeleteGlossary deletes a specific glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.delete
-func (s *GlossariesService) DeleteGlossary(ctx context.Context, glossaryID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID), nil)
+func (s *GlossariesService) DeleteGlossary(ctx context.Context, glossaryID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "GlossariesService.DeleteGlossary")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d", glossaryID), nil, reqOpts...)
 }
 
 // ExportGlossary performs an export of a glossary.
 // The export operation is asynchronous and returns the status of the export process.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.exports.post
-func (s *GlossariesService) ExportGlossary(ctx context.Context, glossaryID int, req *model.GlossaryExportRequest) (
+func (s *GlossariesService) ExportGlossary(ctx context.Context, glossaryID int, req *model.GlossaryExportRequest, reqOpts ...RequestOption) (
 	*model.GlossaryExport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.ExportGlossary")
 	res := new(model.GlossaryExportResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports", glossaryID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports", glossaryID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -146,23 +158,35 @@ func (s *GlossariesService) ExportGlossary(ctx context.Context, glossaryID int,
 // CheckGlossaryExportStatus returns the status of a glossary export.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.exports.get
-func (s *GlossariesService) CheckGlossaryExportStatus(ctx context.Context, glossaryID int, exportID string) (
+func (s *GlossariesService) CheckGlossaryExportStatus(ctx context.Context, glossaryID int, exportID string, reqOpts ...RequestOption) (
 	*model.GlossaryExport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.CheckGlossaryExportStatus")
 	res := new(model.GlossaryExportResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports/%s", glossaryID, exportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports/%s", glossaryID, exportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// GlossaryExportOperation returns an operation polling the status of the glossary export
+// with CheckGlossaryExportStatus.
+func (s *GlossariesService) GlossaryExportOperation(glossaryID int, exportID string, reqOpts ...RequestOption) *Operation[model.GlossaryExport] {
+	return newOperation(func(ctx context.Context) (*model.GlossaryExport, *Response, error) {
+		return s.CheckGlossaryExportStatus(ctx, glossaryID, exportID, reqOpts...)
+	}, func(v *model.GlossaryExport) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // DownloadGlossary returns a download link for a glossary export.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.exports.download.download
-func (s *GlossariesService) DownloadGlossary(ctx context.Context, glossaryID int, exportID string) (
+func (s *GlossariesService) DownloadGlossary(ctx context.Context, glossaryID int, exportID string, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.DownloadGlossary")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports/%s/download", glossaryID, exportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/exports/%s/download", glossaryID, exportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -171,35 +195,82 @@ func (s *GlossariesService) DownloadGlossary(ctx context.Context, glossaryID int
 // The import operation is asynchronous and returns the status of the import process.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.imports.post
-func (s *GlossariesService) ImportGlossary(ctx context.Context, glossaryID int, req *model.GlossaryImportRequest) (
+func (s *GlossariesService) ImportGlossary(ctx context.Context, glossaryID int, req *model.GlossaryImportRequest, reqOpts ...RequestOption) (
 	*model.GlossaryImport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.ImportGlossary")
 	res := new(model.GlossaryImportResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/imports", glossaryID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/imports", glossaryID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// ImportGlossaryFromReader uploads the content read from `r` to the storage,
+// imports it to the glossary and waits for the import to end. It returns the
+// last status of the import. The storage is deleted once the import has ended.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.glossaries.imports.post
+func (s *GlossariesService) ImportGlossaryFromReader(ctx context.Context, glossaryID int, name string, r io.Reader, req *model.GlossaryImportRequest,
+	reqOpts ...RequestOption,
+) (*model.GlossaryImport, *Response, error) {
+	ctx = withOperation(ctx, "GlossariesService.ImportGlossaryFromReader")
+	start := func(storageID int) (*model.GlossaryImport, *Response, error) {
+		imp := model.GlossaryImportRequest{}
+		if req != nil {
+			imp = *req
+		}
+		imp.StorageID = storageID
+		return s.ImportGlossary(ctx, glossaryID, &imp, reqOpts...)
+	}
+	return withImportStorage(ctx, s.client.Storages, name, r, reqOpts, start, func(imp *model.GlossaryImport) *Operation[model.GlossaryImport] {
+		return s.importOperation(glossaryID, imp.Identifier, reqOpts...)
+	})
+}
+
 // CheckGlossaryImportStatus returns the status of a glossary import.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.imports.get
-func (s *GlossariesService) CheckGlossaryImportStatus(ctx context.Context, glossaryID, importID int) (
+func (s *GlossariesService) CheckGlossaryImportStatus(ctx context.Context, glossaryID, importID int, reqOpts ...RequestOption) (
+	*model.GlossaryImport, *Response, error,
+) {
+	ctx = withOperation(ctx, "GlossariesService.CheckGlossaryImportStatus")
+	return s.checkImportStatus(ctx, glossaryID, strconv.Itoa(importID), reqOpts...)
+}
+
+// checkImportStatus returns the status of a glossary import
+// by the identifier returned by ImportGlossary.
+func (s *GlossariesService) checkImportStatus(ctx context.Context, glossaryID int, importID string, reqOpts ...RequestOption) (
 	*model.GlossaryImport, *Response, error,
 ) {
 	res := new(model.GlossaryImportResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/imports/%d", glossaryID, importID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/imports/%s", glossaryID, importID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// GlossaryImportOperation returns an operation polling the status of the glossary import
+// with CheckGlossaryImportStatus.
+func (s *GlossariesService) GlossaryImportOperation(glossaryID, importID int, reqOpts ...RequestOption) *Operation[model.GlossaryImport] {
+	return s.importOperation(glossaryID, strconv.Itoa(importID), reqOpts...)
+}
+
+func (s *GlossariesService) importOperation(glossaryID int, importID string, reqOpts ...RequestOption) *Operation[model.GlossaryImport] {
+	return newOperation(func(ctx context.Context) (*model.GlossaryImport, *Response, error) {
+		return s.checkImportStatus(withOperation(ctx, "GlossariesService.CheckGlossaryImportStatus"), glossaryID, importID, reqOpts...)
+	}, func(v *model.GlossaryImport) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // ConcordanceSearch searches for concordance in the glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.glossaries.concordance.post
-func (s *GlossariesService) ConcordanceSearch(ctx context.Context, projectID int, req *model.GlossaryConcordanceSearchRequest) (
+func (s *GlossariesService) ConcordanceSearch(ctx context.Context, projectID int, req *model.GlossaryConcordanceSearchRequest, reqOpts ...RequestOption) (
 	[]*model.ConcordanceSearch, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.ConcordanceSearch")
 	res := new(model.GlossaryConcordanceSearchResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/glossaries/concordance", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/glossaries/concordance", projectID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -215,11 +286,12 @@ func (s *GlossariesService) ConcordanceSearch(ctx context.Context, projectID int
 // GetTerm returns a specific term from a glossary by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.terms.get
-func (s *GlossariesService) GetTerm(ctx context.Context, glossaryID, termID int) (
+func (s *GlossariesService) GetTerm(ctx context.Context, glossaryID, termID int, reqOpts ...RequestOption) (
 	*model.Term, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.GetTerm")
 	res := new(model.TermResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -227,11 +299,12 @@ func (s *GlossariesService) GetTerm(ctx context.Context, glossaryID, termID int)
 // ListTerms returns a list of terms from a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.terms.getMany
-func (s *GlossariesService) ListTerms(ctx context.Context, glossaryID int, opts *model.TermsListOptions) (
+func (s *GlossariesService) ListTerms(ctx context.Context, glossaryID int, opts *model.TermsListOptions, reqOpts ...RequestOption) (
 	[]*model.Term, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.ListTerms")
 	res := new(model.TermsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms", glossaryID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms", glossaryID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -247,11 +320,12 @@ func (s *GlossariesService) ListTerms(ctx context.Context, glossaryID int, opts
 // AddTerm adds a new term to a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.terms.post
-func (s *GlossariesService) AddTerm(ctx context.Context, glossaryID int, req *model.TermAddRequest) (
+func (s *GlossariesService) AddTerm(ctx context.Context, glossaryID int, req *model.TermAddRequest, reqOpts ...RequestOption) (
 	*model.Term, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.AddTerm")
 	res := new(model.TermResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms", glossaryID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms", glossaryID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -259,11 +333,12 @@ func (s *GlossariesService) AddTerm(ctx context.Context, glossaryID int, req *mo
 // EditTerm updates a specific term.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.terms.patch
-func (s *GlossariesService) EditTerm(ctx context.Context, glossaryID, termID int, req []*model.UpdateRequest) (
+func (s *GlossariesService) EditTerm(ctx context.Context, glossaryID, termID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Term, *Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.EditTerm")
 	res := new(model.TermResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -271,20 +346,22 @@ func (s *GlossariesService) EditTerm(ctx context.Context, glossaryID, termID int
 // ClearGlossary deletes all terms from a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.terms.deleteMany
-func (s *GlossariesService) ClearGlossary(ctx context.Context, glossaryID int, opts *model.ClearGlossaryOptions) (
+func (s *GlossariesService) ClearGlossary(ctx context.Context, glossaryID int, opts *model.ClearGlossaryOptions, reqOpts ...RequestOption) (
 	*Response, error,
 ) {
+	ctx = withOperation(ctx, "GlossariesService.ClearGlossary")
 	path := fmt.Sprintf("/api/v2/glossaries/%d/terms", glossaryID)
 	if v, ok := opts.Values(); ok {
 		path += "?" + v.Encode()
 	}
 
-	return s.client.Delete(ctx, path, nil)
+	return s.client.Delete(ctx, path, nil, reqOpts...)
 }
 
 // DeleteTerm deletes a specific term from a glossary.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.glossaries.terms.delete
-func (s *GlossariesService) DeleteTerm(ctx context.Context, glossaryID, termID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID), nil)
+func (s *GlossariesService) DeleteTerm(ctx context.Context, glossaryID, termID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "GlossariesService.DeleteTerm")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/glossaries/%d/terms/%d", glossaryID, termID), nil, reqOpts...)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
@@ -1374,3 +1375,28 @@ func TestGlossariesService_DeleteTerm(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 }
+
+func TestGlossariesService_ImportGlossaryFromReader(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "term,description")
+	mux.HandleFunc("/api/v2/glossaries/2/imports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, `{"storageId": 61, "scheme": null, "firstLineContainsHeader": true}`)
+		fmt.Fprint(w, `{"data": {"identifier": "b5215a34", "status": "created"}}`)
+	})
+	// The import reads the storage after the import request has returned.
+	mux.HandleFunc("/api/v2/glossaries/2/imports/b5215a34", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Empty(t, *deleted, "storage is deleted before the import has read it")
+		fmt.Fprint(w, `{"data": {"identifier": "b5215a34", "status": "finished", "progress": 100}}`)
+	})
+
+	imp, _, err := client.Glossaries.ImportGlossaryFromReader(context.Background(), 2, "glossary.csv", strings.NewReader("term,description"),
+		&model.GlossaryImportRequest{FirstLineContainsHeader: ToPtr(true)})
+	require.NoError(t, err)
+	assert.Equal(t, "b5215a34", imp.Identifier)
+	assert.Equal(t, "finished", imp.Status)
+	assert.Equal(t, []string{"61"}, *deleted)
+}
@@ -2,6 +2,8 @@ package crowdin
 
 import (
 	"context"
+	"net/http"
+	"strings"
 )
 
 type GraphQL struct {
@@ -37,7 +39,7 @@ func (r *Request) Operation(name string) {
 
 // Query sends a request to the GraphQL server with the given query and then
 // unmarshals the response into the given v which should be a pointer.
-func (g *GraphQL) Query(ctx context.Context, req *Request, v any) error {
+func (g *GraphQL) Query(ctx context.Context, req *Request, v any, reqOpts ...RequestOption) error {
 	body := struct {
 		Query         string         `json:"query"`
 		Variables     map[string]any `json:"variables,omitempty"`
@@ -48,6 +50,35 @@ func (g *GraphQL) Query(ctx context.Context, req *Request, v any) error {
 		OperationName: req.opName,
 	}
 
-	_, err := g.client.Post(ctx, "/api/graphql", body, v)
+	opts := []RequestOption{graphQLRequest()}
+	if !strings.HasPrefix(strings.TrimSpace(req.q), "mutation") {
+		// Queries do not modify data and can be safely retried.
+		opts = append(opts, Idempotent())
+	}
+
+	path := "/api/graphql"
+	if g.client.graphQLURL != nil {
+		path = g.client.graphQLURL.String()
+	}
+
+	_, err := g.client.Post(ctx, path, body, v, append(opts, reqOpts...)...)
 	return err
 }
+
+type graphQLKey struct{}
+
+// graphQLRequest marks the request as a GraphQL request.
+func graphQLRequest() RequestOption {
+	return func(r *http.Request) error {
+		withContextValue(r, graphQLKey{}, true)
+		return nil
+	}
+}
+
+// isGraphQL reports whether the request was sent to the GraphQL endpoint.
+func isGraphQL(r *http.Request) bool {
+	if ok, _ := r.Context().Value(graphQLKey{}).(bool); ok {
+		return true
+	}
+	return strings.Contains(r.URL.Path, "graphql")
+}
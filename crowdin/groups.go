@@ -20,9 +20,10 @@ type GroupsService struct {
 // List returns a list of groups.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.getMany
-func (s *GroupsService) List(ctx context.Context, opts *model.GroupsListOptions) ([]*model.Group, *Response, error) {
+func (s *GroupsService) List(ctx context.Context, opts *model.GroupsListOptions, reqOpts ...RequestOption) ([]*model.Group, *Response, error) {
+	ctx = withOperation(ctx, "GroupsService.List")
 	res := new(model.GroupsListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/groups", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/groups", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -38,9 +39,10 @@ func (s *GroupsService) List(ctx context.Context, opts *model.GroupsListOptions)
 // Get returns a group by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.get
-func (s *GroupsService) Get(ctx context.Context, id int) (*model.Group, *Response, error) {
+func (s *GroupsService) Get(ctx context.Context, id int, reqOpts ...RequestOption) (*model.Group, *Response, error) {
+	ctx = withOperation(ctx, "GroupsService.Get")
 	res := new(model.GroupsGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d", id), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d", id), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -48,9 +50,10 @@ func (s *GroupsService) Get(ctx context.Context, id int) (*model.Group, *Respons
 // Add creates a new group.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.post
-func (s *GroupsService) Add(ctx context.Context, req *model.GroupsAddRequest) (*model.Group, *Response, error) {
+func (s *GroupsService) Add(ctx context.Context, req *model.GroupsAddRequest, reqOpts ...RequestOption) (*model.Group, *Response, error) {
+	ctx = withOperation(ctx, "GroupsService.Add")
 	res := new(model.GroupsGetResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/groups", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/groups", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -63,9 +66,10 @@ func (s *GroupsService) Add(ctx context.Context, req *model.GroupsAddRequest) (*
 //   - value: The value to be used within the operations. The value must be one of string or integer.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.patch
-func (s *GroupsService) Edit(ctx context.Context, id int, req []*model.UpdateRequest) (*model.Group, *Response, error) {
+func (s *GroupsService) Edit(ctx context.Context, id int, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.Group, *Response, error) {
+	ctx = withOperation(ctx, "GroupsService.Edit")
 	res := new(model.GroupsGetResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/groups/%d", id), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/groups/%d", id), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -73,6 +77,7 @@ func (s *GroupsService) Edit(ctx context.Context, id int, req []*model.UpdateReq
 // Delete removes a group from the organization.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.delete
-func (s *GroupsService) Delete(ctx context.Context, id int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/groups/%d", id), nil)
+func (s *GroupsService) Delete(ctx context.Context, id int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "GroupsService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/groups/%d", id), nil, reqOpts...)
 }
@@ -0,0 +1,229 @@
+// Command listall generates the ListAll iterators of the paginated
+// list methods of the crowdin services.
+//
+// A method is paginated if it is an exported method of a service, accepts
+// list options embedding model.ListOptions and returns a slice of items.
+// The iterator is named after the method with "All" inserted after the
+// verb, e.g. List -> ListAll, ListProjectBuilds -> ListAllProjectBuilds.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the crowdin package")
+	output := flag.String("output", "list_all_gen.go", "output file name")
+	flag.Parse()
+
+	src, err := generate(*dir, *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// method is a paginated list method.
+type method struct {
+	file    string
+	pos     token.Pos
+	service string
+	name    string
+	params  []string
+	args    []string
+	opts    string
+	optsTyp string
+	item    string
+}
+
+func generate(dir, output string) ([]byte, error) {
+	fset := token.NewFileSet()
+
+	paged, err := pagedOptions(fset, filepath.Join(dir, "model"))
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := parseDir(fset, dir, output)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []method
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if m, ok := listMethod(fset, fd, paged); ok {
+				methods = append(methods, m)
+			}
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		if methods[i].file != methods[j].file {
+			return methods[i].file < methods[j].file
+		}
+		return methods[i].pos < methods[j].pos
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprint(&buf, "// Code generated by internal/gen/listall; DO NOT EDIT.\n\n")
+	fmt.Fprint(&buf, "package crowdin\n\n")
+	fmt.Fprint(&buf, "import (\n\t\"context\"\n\t\"iter\"\n\n\t\"github.com/crowdin/crowdin-api-client-go/crowdin/model\"\n)\n")
+	for _, m := range methods {
+		all := iteratorName(m.name)
+		fmt.Fprintf(&buf, "\n// %s returns an iterator over the items of all the pages of %s.\n", all, m.name)
+		fmt.Fprintf(&buf, "// The pages are fetched lazily with %s.Limit items per page (defaults to MaxPageSize).\n", m.opts)
+		fmt.Fprintf(&buf, "func (s *%s) %s(%s) iter.Seq2[%s, error] {\n", m.service, all, strings.Join(m.params, ", "), m.item)
+		fmt.Fprintf(&buf, "\treturn listAll(ctx, %s, func(ctx context.Context, %[1]s %s) ([]%s, *Response, error) {\n", m.opts, m.optsTyp, m.item)
+		fmt.Fprintf(&buf, "\t\treturn s.%s(%s)\n", m.name, strings.Join(m.args, ", "))
+		fmt.Fprint(&buf, "\t})\n}\n")
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// parseDir parses the non-test Go files of the directory
+// except the generated output.
+func parseDir(fset *token.FileSet, dir, output string) ([]*ast.File, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, name := range matches {
+		if strings.HasSuffix(name, "_test.go") || filepath.Base(name) == output {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// pagedOptions returns the names of the model types that
+// support pagination.
+func pagedOptions(fset *token.FileSet, dir string) (map[string]bool, error) {
+	files, err := parseDir(fset, dir, "")
+	if err != nil {
+		return nil, err
+	}
+
+	paged := map[string]bool{"ListOptions": true}
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			ts, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+			for _, field := range st.Fields.List {
+				if id, ok := field.Type.(*ast.Ident); ok && len(field.Names) == 0 && id.Name == "ListOptions" {
+					paged[ts.Name.Name] = true
+				}
+			}
+			return false
+		})
+	}
+	return paged, nil
+}
+
+// listMethod reports whether the function is a paginated
+// list method of a service.
+func listMethod(fset *token.FileSet, fd *ast.FuncDecl, paged map[string]bool) (method, bool) {
+	if fd.Recv == nil || !fd.Name.IsExported() || fd.Type.Results == nil || len(fd.Type.Results.List) != 3 {
+		return method{}, false
+	}
+
+	star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return method{}, false
+	}
+	recv, ok := star.X.(*ast.Ident)
+	if !ok || !strings.HasSuffix(recv.Name, "Service") {
+		return method{}, false
+	}
+
+	slice, ok := fd.Type.Results.List[0].Type.(*ast.ArrayType)
+	if !ok || slice.Len != nil {
+		return method{}, false
+	}
+
+	m := method{
+		file:    fset.Position(fd.Pos()).Filename,
+		pos:     fd.Pos(),
+		service: recv.Name,
+		name:    fd.Name.Name,
+		item:    expr(fset, slice.Elt),
+	}
+	for _, field := range fd.Type.Params.List {
+		typ := expr(fset, field.Type)
+		if sel, ok := unstar(field.Type).(*ast.SelectorExpr); ok && paged[sel.Sel.Name] && len(field.Names) == 1 {
+			m.opts, m.optsTyp = field.Names[0].Name, typ
+		}
+
+		var names []string
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				m.args = append(m.args, name.Name+"...")
+			} else {
+				m.args = append(m.args, name.Name)
+			}
+		}
+		m.params = append(m.params, strings.Join(names, ", ")+" "+typ)
+	}
+	if m.opts == "" {
+		return method{}, false
+	}
+
+	return m, true
+}
+
+func unstar(e ast.Expr) ast.Expr {
+	if star, ok := e.(*ast.StarExpr); ok {
+		return star.X
+	}
+	return e
+}
+
+func expr(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, e); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+// iteratorName inserts "All" after the verb of the method name.
+func iteratorName(name string) string {
+	i := 1
+	for i < len(name) && !unicode.IsUpper(rune(name[i])) {
+		i++
+	}
+	return name[:i] + "All" + name[i:]
+}
@@ -18,9 +18,10 @@ type LabelsService struct {
 // Get returns a label by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.get
-func (s *LabelsService) Get(ctx context.Context, projectID, labelID int) (*model.Label, *Response, error) {
+func (s *LabelsService) Get(ctx context.Context, projectID, labelID int, reqOpts ...RequestOption) (*model.Label, *Response, error) {
+	ctx = withOperation(ctx, "LabelsService.Get")
 	res := new(model.LabelResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -28,11 +29,12 @@ func (s *LabelsService) Get(ctx context.Context, projectID, labelID int) (*model
 // List returns a list of labels in the project.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.getMany
-func (s *LabelsService) List(ctx context.Context, projectID int, opts *model.LabelsListOptions) (
+func (s *LabelsService) List(ctx context.Context, projectID int, opts *model.LabelsListOptions, reqOpts ...RequestOption) (
 	[]*model.Label, *Response, error,
 ) {
+	ctx = withOperation(ctx, "LabelsService.List")
 	res := new(model.LabelsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/labels", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/labels", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -48,11 +50,12 @@ func (s *LabelsService) List(ctx context.Context, projectID int, opts *model.Lab
 // Add creates a new label in the project.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.post
-func (s *LabelsService) Add(ctx context.Context, projectID int, req *model.LabelAddRequest) (
+func (s *LabelsService) Add(ctx context.Context, projectID int, req *model.LabelAddRequest, reqOpts ...RequestOption) (
 	*model.Label, *Response, error,
 ) {
+	ctx = withOperation(ctx, "LabelsService.Add")
 	res := new(model.LabelResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/labels", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/labels", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -65,11 +68,12 @@ func (s *LabelsService) Add(ctx context.Context, projectID int, req *model.Label
 // - value (string) - new value for the field. Must be a string.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.patch
-func (s *LabelsService) Edit(ctx context.Context, projectID, labelID int, req []*model.UpdateRequest) (
+func (s *LabelsService) Edit(ctx context.Context, projectID, labelID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Label, *Response, error,
 ) {
+	ctx = withOperation(ctx, "LabelsService.Edit")
 	res := new(model.LabelResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -77,8 +81,9 @@ func (s *LabelsService) Edit(ctx context.Context, projectID, labelID int, req []
 // Delete removes a label by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.delete
-func (s *LabelsService) Delete(ctx context.Context, projectID, labelID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID), nil)
+func (s *LabelsService) Delete(ctx context.Context, projectID, labelID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "LabelsService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d", projectID, labelID), nil, reqOpts...)
 }
 
 // AssignToStrings assigns label to strings and returns a list of strings
@@ -86,15 +91,16 @@ func (s *LabelsService) Delete(ctx context.Context, projectID, labelID int) (*Re
 // Note: You can assign up to 500 strings at a time.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.strings.post
-func (s *LabelsService) AssignToStrings(ctx context.Context, projectID, labelID int, stringIDs []int) (
+func (s *LabelsService) AssignToStrings(ctx context.Context, projectID, labelID int, stringIDs []int, reqOpts ...RequestOption) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = withOperation(ctx, "LabelsService.AssignToStrings")
 	var (
 		req = &model.AssignToStringsRequest{StringIDs: stringIDs}
 		res = &model.SourceStringsListResponse{}
 	)
 
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d/strings", projectID, labelID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d/strings", projectID, labelID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -112,16 +118,17 @@ func (s *LabelsService) AssignToStrings(ctx context.Context, projectID, labelID
 // Note: You can unassign up to 500 strings at a time.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.strings.deleteMany
-func (s *LabelsService) UnassignFromStrings(ctx context.Context, projectID, labelID int, stringIDs []int) (
+func (s *LabelsService) UnassignFromStrings(ctx context.Context, projectID, labelID int, stringIDs []int, reqOpts ...RequestOption) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = withOperation(ctx, "LabelsService.UnassignFromStrings")
 	if len(stringIDs) == 0 {
 		return nil, nil, errors.New("stringIDs cannot be empty")
 	}
 
 	res := new(model.SourceStringsListResponse)
 	path := "/api/v2/projects/%d/labels/%d/strings?stringIds=%s"
-	resp, err := s.client.Delete(ctx, fmt.Sprintf(path, projectID, labelID, model.JoinSlice(stringIDs)), res)
+	resp, err := s.client.Delete(ctx, fmt.Sprintf(path, projectID, labelID, model.JoinSlice(stringIDs)), res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -139,15 +146,16 @@ func (s *LabelsService) UnassignFromStrings(ctx context.Context, projectID, labe
 // Note: You can assign up to 500 screenshots at a time.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.screenshots.post
-func (s *LabelsService) AssignToScreenshots(ctx context.Context, projectID, labelID int, screenshotIDs []int) (
+func (s *LabelsService) AssignToScreenshots(ctx context.Context, projectID, labelID int, screenshotIDs []int, reqOpts ...RequestOption) (
 	[]*model.Screenshot, *Response, error,
 ) {
+	ctx = withOperation(ctx, "LabelsService.AssignToScreenshots")
 	var (
 		req = &model.AssignToScreenshotsRequest{ScreenshotIDs: screenshotIDs}
 		res = &model.ScreenshotListResponse{}
 	)
 
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d/screenshots", projectID, labelID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/labels/%d/screenshots", projectID, labelID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -165,16 +173,17 @@ func (s *LabelsService) AssignToScreenshots(ctx context.Context, projectID, labe
 // Note: You can unassign up to 500 screenshots at a time.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.labels.screenshots.deleteMany
-func (s *LabelsService) UnassignFromScreenshots(ctx context.Context, projectID, labelID int, screenshotIDs []int) (
+func (s *LabelsService) UnassignFromScreenshots(ctx context.Context, projectID, labelID int, screenshotIDs []int, reqOpts ...RequestOption) (
 	[]*model.Screenshot, *Response, error,
 ) {
+	ctx = withOperation(ctx, "LabelsService.UnassignFromScreenshots")
 	if len(screenshotIDs) == 0 {
 		return nil, nil, errors.New("screenshotIDs cannot be empty")
 	}
 
 	res := new(model.ScreenshotListResponse)
 	path := "/api/v2/projects/%d/labels/%d/screenshots?screenshotIds=%s"
-	resp, err := s.client.Delete(ctx, fmt.Sprintf(path, projectID, labelID, model.JoinSlice(screenshotIDs)), res)
+	resp, err := s.client.Delete(ctx, fmt.Sprintf(path, projectID, labelID, model.JoinSlice(screenshotIDs)), res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -19,9 +19,10 @@ type LanguagesService struct {
 // List returns a list of all supported languages.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.getMany
-func (s *LanguagesService) List(ctx context.Context, opts *model.ListOptions) ([]*model.Language, *Response, error) {
+func (s *LanguagesService) List(ctx context.Context, opts *model.ListOptions, reqOpts ...RequestOption) ([]*model.Language, *Response, error) {
+	ctx = withOperation(ctx, "LanguagesService.List")
 	res := new(model.LanguagesListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/languages", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/languages", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -37,9 +38,10 @@ func (s *LanguagesService) List(ctx context.Context, opts *model.ListOptions) ([
 // Get returns a language by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.get
-func (s *LanguagesService) Get(ctx context.Context, id string) (*model.Language, *Response, error) {
+func (s *LanguagesService) Get(ctx context.Context, id string, reqOpts ...RequestOption) (*model.Language, *Response, error) {
+	ctx = withOperation(ctx, "LanguagesService.Get")
 	res := new(model.LanguagesGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/languages/%s", id), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/languages/%s", id), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -47,9 +49,10 @@ func (s *LanguagesService) Get(ctx context.Context, id string) (*model.Language,
 // Add adds a new custom language.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.post
-func (s *LanguagesService) Add(ctx context.Context, req *model.AddLanguageRequest) (*model.Language, *Response, error) {
+func (s *LanguagesService) Add(ctx context.Context, req *model.AddLanguageRequest, reqOpts ...RequestOption) (*model.Language, *Response, error) {
+	ctx = withOperation(ctx, "LanguagesService.Add")
 	res := new(model.LanguagesGetResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/languages", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/languages", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -63,9 +66,10 @@ func (s *LanguagesService) Add(ctx context.Context, req *model.AddLanguageReques
 //   - value: The value to be used within the operations. The value must be one of string or array of strings.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.patch
-func (s *LanguagesService) Edit(ctx context.Context, id string, req []*model.UpdateRequest) (*model.Language, *Response, error) {
+func (s *LanguagesService) Edit(ctx context.Context, id string, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.Language, *Response, error) {
+	ctx = withOperation(ctx, "LanguagesService.Edit")
 	res := new(model.LanguagesGetResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/languages/%s", id), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/languages/%s", id), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -73,6 +77,7 @@ func (s *LanguagesService) Edit(ctx context.Context, id string, req []*model.Upd
 // Delete deletes a custom language by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.languages.delete
-func (s *LanguagesService) Delete(ctx context.Context, id string) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/languages/%s", id), nil)
+func (s *LanguagesService) Delete(ctx context.Context, id string, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "LanguagesService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/languages/%s", id), nil, reqOpts...)
 }
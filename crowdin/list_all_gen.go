@@ -0,0 +1,490 @@
+// Code generated by internal/gen/listall; DO NOT EDIT.
+
+package crowdin
+
+import (
+	"context"
+	"iter"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// ListAllFineTuningJobs returns an iterator over the items of all the pages of ListFineTuningJobs.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *AIService) ListAllFineTuningJobs(ctx context.Context, userID int, opts *model.FineTuningJobsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.FineTuningJob, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.FineTuningJobsListOptions) ([]*model.FineTuningJob, *Response, error) {
+		return s.ListFineTuningJobs(ctx, userID, opts, reqOpts...)
+	})
+}
+
+// ListAllPrompts returns an iterator over the items of all the pages of ListPrompts.
+// The pages are fetched lazily with opt.Limit items per page (defaults to MaxPageSize).
+func (s *AIService) ListAllPrompts(ctx context.Context, userID int, opt *model.AIPromtsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Prompt, error] {
+	return listAll(ctx, opt, func(ctx context.Context, opt *model.AIPromtsListOptions) ([]*model.Prompt, *Response, error) {
+		return s.ListPrompts(ctx, userID, opt, reqOpts...)
+	})
+}
+
+// ListAllProviders returns an iterator over the items of all the pages of ListProviders.
+// The pages are fetched lazily with opt.Limit items per page (defaults to MaxPageSize).
+func (s *AIService) ListAllProviders(ctx context.Context, userID int, opt *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Provider, error] {
+	return listAll(ctx, opt, func(ctx context.Context, opt *model.ListOptions) ([]*model.Provider, *Response, error) {
+		return s.ListProviders(ctx, userID, opt, reqOpts...)
+	})
+}
+
+// ListAllInstallations returns an iterator over the items of all the pages of ListInstallations.
+// The pages are fetched lazily with opt.Limit items per page (defaults to MaxPageSize).
+func (s *ApplicationsService) ListAllInstallations(ctx context.Context, opt *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Installation, error] {
+	return listAll(ctx, opt, func(ctx context.Context, opt *model.ListOptions) ([]*model.Installation, *Response, error) {
+		return s.ListInstallations(ctx, opt, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *BranchesService) ListAll(ctx context.Context, projectID int, opts *model.BranchesListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Branch, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.BranchesListOptions) ([]*model.Branch, *Response, error) {
+		return s.List(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *BundlesService) ListAll(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Bundle, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.Bundle, *Response, error) {
+		return s.List(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllFiles returns an iterator over the items of all the pages of ListFiles.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *BundlesService) ListAllFiles(ctx context.Context, projectID, bundleID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.File, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.File, *Response, error) {
+		return s.ListFiles(ctx, projectID, bundleID, opts, reqOpts...)
+	})
+}
+
+// ListAllBranches returns an iterator over the items of all the pages of ListBranches.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *BundlesService) ListAllBranches(ctx context.Context, projectID, bundleID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Branch, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.Branch, *Response, error) {
+		return s.ListBranches(ctx, projectID, bundleID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *DistributionsService) ListAll(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Distribution, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.Distribution, *Response, error) {
+		return s.List(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *FieldsService) ListAll(ctx context.Context, opts *model.FieldsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Field, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.FieldsListOptions) ([]*model.Field, *Response, error) {
+		return s.List(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllConcepts returns an iterator over the items of all the pages of ListConcepts.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *GlossariesService) ListAllConcepts(ctx context.Context, glossaryID int, opts *model.ConceptsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Concept, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ConceptsListOptions) ([]*model.Concept, *Response, error) {
+		return s.ListConcepts(ctx, glossaryID, opts, reqOpts...)
+	})
+}
+
+// ListAllGlossaries returns an iterator over the items of all the pages of ListGlossaries.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *GlossariesService) ListAllGlossaries(ctx context.Context, opts *model.GlossariesListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Glossary, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.GlossariesListOptions) ([]*model.Glossary, *Response, error) {
+		return s.ListGlossaries(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllTerms returns an iterator over the items of all the pages of ListTerms.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *GlossariesService) ListAllTerms(ctx context.Context, glossaryID int, opts *model.TermsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Term, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.TermsListOptions) ([]*model.Term, *Response, error) {
+		return s.ListTerms(ctx, glossaryID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *GroupsService) ListAll(ctx context.Context, opts *model.GroupsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Group, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.GroupsListOptions) ([]*model.Group, *Response, error) {
+		return s.List(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *LabelsService) ListAll(ctx context.Context, projectID int, opts *model.LabelsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Label, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.LabelsListOptions) ([]*model.Label, *Response, error) {
+		return s.List(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *LanguagesService) ListAll(ctx context.Context, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Language, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.Language, *Response, error) {
+		return s.List(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllMT returns an iterator over the items of all the pages of ListMT.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *MachineTranslationEnginesService) ListAllMT(ctx context.Context, opts *model.MTListOptions, reqOpts ...RequestOption) iter.Seq2[*model.MachineTranslation, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.MTListOptions) ([]*model.MachineTranslation, *Response, error) {
+		return s.ListMT(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *ProjectsService) ListAll(ctx context.Context, opts *model.ProjectsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Project, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ProjectsListOptions) ([]*model.Project, *Response, error) {
+		return s.List(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllArchives returns an iterator over the items of all the pages of ListArchives.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *ReportsService) ListAllArchives(ctx context.Context, userID int, opts *model.ReportArchivesListOptions, reqOpts ...RequestOption) iter.Seq2[*model.ReportArchive, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ReportArchivesListOptions) ([]*model.ReportArchive, *Response, error) {
+		return s.ListArchives(ctx, userID, opts, reqOpts...)
+	})
+}
+
+// ListAllSettingsTemplates returns an iterator over the items of all the pages of ListSettingsTemplates.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *ReportsService) ListAllSettingsTemplates(ctx context.Context, projectID int, opts *model.ReportSettingsTemplatesListOptions, reqOpts ...RequestOption) iter.Seq2[*model.ReportSettingsTemplate, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ReportSettingsTemplatesListOptions) ([]*model.ReportSettingsTemplate, *Response, error) {
+		return s.ListSettingsTemplates(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllUserSettingsTemplates returns an iterator over the items of all the pages of ListUserSettingsTemplates.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *ReportsService) ListAllUserSettingsTemplates(ctx context.Context, userID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.ReportSettingsTemplate, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.ReportSettingsTemplate, *Response, error) {
+		return s.ListUserSettingsTemplates(ctx, userID, opts, reqOpts...)
+	})
+}
+
+// ListAllScreenshots returns an iterator over the items of all the pages of ListScreenshots.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *ScreenshotsService) ListAllScreenshots(ctx context.Context, projectID int, opts *model.ScreenshotListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Screenshot, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ScreenshotListOptions) ([]*model.Screenshot, *Response, error) {
+		return s.ListScreenshots(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllTags returns an iterator over the items of all the pages of ListTags.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *ScreenshotsService) ListAllTags(ctx context.Context, projectID, screenshotID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Tag, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.Tag, *Response, error) {
+		return s.ListTags(ctx, projectID, screenshotID, opts, reqOpts...)
+	})
+}
+
+// ListAllUserLogs returns an iterator over the items of all the pages of ListUserLogs.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *SecurityLogsService) ListAllUserLogs(ctx context.Context, userID int, opts *model.SecurityLogsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.SecurityLog, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.SecurityLogsListOptions) ([]*model.SecurityLog, *Response, error) {
+		return s.ListUserLogs(ctx, userID, opts, reqOpts...)
+	})
+}
+
+// ListAllOrganizationLogs returns an iterator over the items of all the pages of ListOrganizationLogs.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *SecurityLogsService) ListAllOrganizationLogs(ctx context.Context, opts *model.SecurityLogsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.SecurityLog, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.SecurityLogsListOptions) ([]*model.SecurityLog, *Response, error) {
+		return s.ListOrganizationLogs(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllDirectories returns an iterator over the items of all the pages of ListDirectories.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *SourceFilesService) ListAllDirectories(ctx context.Context, projectID int, opts *model.DirectoryListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Directory, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.DirectoryListOptions) ([]*model.Directory, *Response, error) {
+		return s.ListDirectories(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllFiles returns an iterator over the items of all the pages of ListFiles.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *SourceFilesService) ListAllFiles(ctx context.Context, projectID int, opts *model.FileListOptions, reqOpts ...RequestOption) iter.Seq2[*model.File, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.FileListOptions) ([]*model.File, *Response, error) {
+		return s.ListFiles(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllFileRevisions returns an iterator over the items of all the pages of ListFileRevisions.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *SourceFilesService) ListAllFileRevisions(ctx context.Context, projectID, fileID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.FileRevision, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.FileRevision, *Response, error) {
+		return s.ListFileRevisions(ctx, projectID, fileID, opts, reqOpts...)
+	})
+}
+
+// ListAllReviewedBuilds returns an iterator over the items of all the pages of ListReviewedBuilds.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *SourceFilesService) ListAllReviewedBuilds(ctx context.Context, projectID int, opts *model.ReviewedBuildListOptions, reqOpts ...RequestOption) iter.Seq2[*model.ReviewedBuild, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ReviewedBuildListOptions) ([]*model.ReviewedBuild, *Response, error) {
+		return s.ListReviewedBuilds(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *SourceStringsService) ListAll(ctx context.Context, projectID int, opts *model.SourceStringsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.SourceString, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.SourceStringsListOptions) ([]*model.SourceString, *Response, error) {
+		return s.List(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *StorageService) ListAll(ctx context.Context, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Storage, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.Storage, *Response, error) {
+		return s.List(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *StringCommentsService) ListAll(ctx context.Context, projectID int, opts *model.StringCommentsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.StringComment, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.StringCommentsListOptions) ([]*model.StringComment, *Response, error) {
+		return s.List(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllApprovals returns an iterator over the items of all the pages of ListApprovals.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *StringTranslationsService) ListAllApprovals(ctx context.Context, projectID int, opts *model.ApprovalsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Approval, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ApprovalsListOptions) ([]*model.Approval, *Response, error) {
+		return s.ListApprovals(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllLanguageTranslations returns an iterator over the items of all the pages of ListLanguageTranslations.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *StringTranslationsService) ListAllLanguageTranslations(ctx context.Context, projectID int, languageID string, opts *model.LanguageTranslationsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.LanguageTranslation, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.LanguageTranslationsListOptions) ([]*model.LanguageTranslation, *Response, error) {
+		return s.ListLanguageTranslations(ctx, projectID, languageID, opts, reqOpts...)
+	})
+}
+
+// ListAllStringTranslations returns an iterator over the items of all the pages of ListStringTranslations.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *StringTranslationsService) ListAllStringTranslations(ctx context.Context, projectID int, opts *model.StringTranslationsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Translation, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.StringTranslationsListOptions) ([]*model.Translation, *Response, error) {
+		return s.ListStringTranslations(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllVotes returns an iterator over the items of all the pages of ListVotes.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *StringTranslationsService) ListAllVotes(ctx context.Context, projectID int, opts *model.VotesListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Vote, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.VotesListOptions) ([]*model.Vote, *Response, error) {
+		return s.ListVotes(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TasksService) ListAll(ctx context.Context, projectID int, opts *model.TasksListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Task, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.TasksListOptions) ([]*model.Task, *Response, error) {
+		return s.List(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllUserTasks returns an iterator over the items of all the pages of ListUserTasks.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TasksService) ListAllUserTasks(ctx context.Context, opts *model.UserTasksListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Task, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.UserTasksListOptions) ([]*model.Task, *Response, error) {
+		return s.ListUserTasks(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllSettingsTemplates returns an iterator over the items of all the pages of ListSettingsTemplates.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TasksService) ListAllSettingsTemplates(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TaskSettingsTemplate, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.TaskSettingsTemplate, *Response, error) {
+		return s.ListSettingsTemplates(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllComments returns an iterator over the items of all the pages of ListComments.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TasksService) ListAllComments(ctx context.Context, projectID, taskID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TaskComment, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.TaskComment, *Response, error) {
+		return s.ListComments(ctx, projectID, taskID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TeamsService) ListAll(ctx context.Context, opts *model.TeamsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Team, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.TeamsListOptions) ([]*model.Team, *Response, error) {
+		return s.List(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllMembers returns an iterator over the items of all the pages of ListMembers.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TeamsService) ListAllMembers(ctx context.Context, teamID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TeamMember, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.TeamMember, *Response, error) {
+		return s.ListMembers(ctx, teamID, opts, reqOpts...)
+	})
+}
+
+// ListAllGroupTeams returns an iterator over the items of all the pages of ListGroupTeams.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TeamsService) ListAllGroupTeams(ctx context.Context, groupID int, opts *model.TeamsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.GroupsTeam, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.TeamsListOptions) ([]*model.GroupsTeam, *Response, error) {
+		return s.ListGroupTeams(ctx, groupID, opts, reqOpts...)
+	})
+}
+
+// ListAllTMs returns an iterator over the items of all the pages of ListTMs.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationMemoryService) ListAllTMs(ctx context.Context, opts *model.TranslationMemoriesListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TranslationMemory, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.TranslationMemoriesListOptions) ([]*model.TranslationMemory, *Response, error) {
+		return s.ListTMs(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllTMSegments returns an iterator over the items of all the pages of ListTMSegments.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationMemoryService) ListAllTMSegments(ctx context.Context, tmID int, opts *model.TMSegmentsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TMSegment, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.TMSegmentsListOptions) ([]*model.TMSegment, *Response, error) {
+		return s.ListTMSegments(ctx, tmID, opts, reqOpts...)
+	})
+}
+
+// GetAllBranchProgress returns an iterator over the items of all the pages of GetBranchProgress.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationStatusService) GetAllBranchProgress(ctx context.Context, projectID, branchID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TranslationProgress, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetBranchProgress(ctx, projectID, branchID, opts, reqOpts...)
+	})
+}
+
+// GetAllDirectoryProgress returns an iterator over the items of all the pages of GetDirectoryProgress.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationStatusService) GetAllDirectoryProgress(ctx context.Context, projectID, directoryID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TranslationProgress, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetDirectoryProgress(ctx, projectID, directoryID, opts, reqOpts...)
+	})
+}
+
+// GetAllFileProgress returns an iterator over the items of all the pages of GetFileProgress.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationStatusService) GetAllFileProgress(ctx context.Context, projectID, fileID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TranslationProgress, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetFileProgress(ctx, projectID, fileID, opts, reqOpts...)
+	})
+}
+
+// GetAllLanguageProgress returns an iterator over the items of all the pages of GetLanguageProgress.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationStatusService) GetAllLanguageProgress(ctx context.Context, projectID int, languageID string, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TranslationProgress, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetLanguageProgress(ctx, projectID, languageID, opts, reqOpts...)
+	})
+}
+
+// GetAllProjectProgress returns an iterator over the items of all the pages of GetProjectProgress.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationStatusService) GetAllProjectProgress(ctx context.Context, projectID int, opts *model.ProjectProgressListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TranslationProgress, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ProjectProgressListOptions) ([]*model.TranslationProgress, *Response, error) {
+		return s.GetProjectProgress(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllQAChecks returns an iterator over the items of all the pages of ListQAChecks.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationStatusService) ListAllQAChecks(ctx context.Context, projectID int, opts *model.QACheckListOptions, reqOpts ...RequestOption) iter.Seq2[*model.QACheck, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.QACheckListOptions) ([]*model.QACheck, *Response, error) {
+		return s.ListQAChecks(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllPreTranslations returns an iterator over the items of all the pages of ListPreTranslations.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationsService) ListAllPreTranslations(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.PreTranslation, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.PreTranslation, *Response, error) {
+		return s.ListPreTranslations(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllProjectBuilds returns an iterator over the items of all the pages of ListProjectBuilds.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *TranslationsService) ListAllProjectBuilds(ctx context.Context, projectID int, opts *model.TranslationsBuildsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.TranslationsProjectBuild, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.TranslationsBuildsListOptions) ([]*model.TranslationsProjectBuild, *Response, error) {
+		return s.ListProjectBuilds(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAllProjectMembers returns an iterator over the items of all the pages of ListProjectMembers.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *UsersService) ListAllProjectMembers(ctx context.Context, projectID int, opts *model.ProjectMembersListOptions, reqOpts ...RequestOption) iter.Seq2[*model.ProjectMember, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ProjectMembersListOptions) ([]*model.ProjectMember, *Response, error) {
+		return s.ListProjectMembers(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *UsersService) ListAll(ctx context.Context, opts *model.UsersListOptions, reqOpts ...RequestOption) iter.Seq2[*model.User, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.UsersListOptions) ([]*model.User, *Response, error) {
+		return s.List(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opt.Limit items per page (defaults to MaxPageSize).
+func (s *VendorsService) ListAll(ctx context.Context, opt *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Vendor, error] {
+	return listAll(ctx, opt, func(ctx context.Context, opt *model.ListOptions) ([]*model.Vendor, *Response, error) {
+		return s.List(ctx, opt, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *WebhooksService) ListAll(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Webhook, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.Webhook, *Response, error) {
+		return s.List(ctx, projectID, opts, reqOpts...)
+	})
+}
+
+// ListAll returns an iterator over the items of all the pages of List.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *OrganizationWebhooksService) ListAll(ctx context.Context, opts *model.ListOptions, reqOpts ...RequestOption) iter.Seq2[*model.Webhook, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.ListOptions) ([]*model.Webhook, *Response, error) {
+		return s.List(ctx, opts, reqOpts...)
+	})
+}
+
+// ListAllStepStrings returns an iterator over the items of all the pages of ListStepStrings.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *WorkflowsService) ListAllStepStrings(ctx context.Context, projectID, stepID int, opts *model.WorkflowStepStringsListOptions, reqOpts ...RequestOption) iter.Seq2[*model.SourceString, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.WorkflowStepStringsListOptions) ([]*model.SourceString, *Response, error) {
+		return s.ListStepStrings(ctx, projectID, stepID, opts, reqOpts...)
+	})
+}
+
+// ListAllTemplates returns an iterator over the items of all the pages of ListTemplates.
+// The pages are fetched lazily with opts.Limit items per page (defaults to MaxPageSize).
+func (s *WorkflowsService) ListAllTemplates(ctx context.Context, opts *model.WorkflowTemplatesListOptions, reqOpts ...RequestOption) iter.Seq2[*model.WorkflowTemplate, error] {
+	return listAll(ctx, opts, func(ctx context.Context, opts *model.WorkflowTemplatesListOptions) ([]*model.WorkflowTemplate, *Response, error) {
+		return s.ListTemplates(ctx, opts, reqOpts...)
+	})
+}
@@ -0,0 +1,221 @@
+package crowdin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveFields are the (lowercase) names of JSON fields whose values
+// are never logged, e.g. AI provider credentials or webhook headers.
+var sensitiveFields = map[string]bool{
+	"accesstoken":   true,
+	"apikey":        true,
+	"authorization": true,
+	"clientsecret":  true,
+	"cookie":        true,
+	"credentials":   true,
+	"headers":       true,
+	"password":      true,
+	"privatekey":    true,
+	"refreshtoken":  true,
+	"secret":        true,
+	"setcookie":     true,
+	"token":         true,
+}
+
+// fieldSeparators are removed from the names of the fields
+// and headers before looking them up in sensitiveFields.
+var fieldSeparators = strings.NewReplacer("-", "", "_", "")
+
+// maxLoggedBody is the maximum size of the logged request and response bodies.
+const maxLoggedBody = 64 << 10
+
+// requestIDHeaders are the response headers carrying the request identifier.
+var requestIDHeaders = []string{"X-Crowdin-Request-Id", "X-Request-Id"}
+
+// WithLogger sets the logger used to log API calls.
+//
+// Every request is logged with its method, path, status, duration,
+// request ID and error type. If the logger is enabled for the debug
+// level, request and response headers and JSON bodies up to 64 KiB are
+// logged too, except the bodies of uploads. The Authorization header and
+// sensitive fields (credentials, tokens, webhook headers, etc.) are always
+// redacted.
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		if l == nil {
+			return errors.New("logger cannot be nil")
+		}
+		c.logger = l
+		return nil
+	}
+}
+
+// logRequest logs the result of a single request attempt.
+func (c *Client) logRequest(r *http.Request, resp *http.Response, body []byte, elapsed time.Duration, err error) {
+	ctx := r.Context()
+
+	level := slog.LevelInfo
+	switch {
+	case resp == nil && err != nil:
+		level = slog.LevelError
+	case err != nil:
+		level = slog.LevelWarn
+	}
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.Duration("duration", elapsed),
+	}
+	if info, ok := CallInfoFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("operation", info.Operation), slog.Int("attempt", info.Attempts))
+	}
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if id := requestID(resp.Header); id != "" {
+			attrs = append(attrs, slog.String("request_id", id))
+		}
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error_type", fmt.Sprintf("%T", unwrapAll(err))), slog.String("error", err.Error()))
+	}
+
+	if c.logger.Enabled(ctx, slog.LevelDebug) {
+		attrs = append(attrs, slog.Any("request_headers", redactHeaders(r.Header)))
+		if b := requestBody(r); b != nil {
+			attrs = append(attrs, slog.String("request_body", string(redactBody(b))))
+		}
+		if resp != nil {
+			attrs = append(attrs, slog.Any("response_headers", redactHeaders(resp.Header)))
+			if isJSON(resp.Header) && len(body) > 0 && len(body) <= maxLoggedBody {
+				attrs = append(attrs, slog.String("response_body", string(redactBody(body))))
+			}
+		}
+	}
+
+	c.logger.LogAttrs(ctx, level, "crowdin: api request", attrs...)
+}
+
+// requestID returns the request identifier from the response headers.
+func requestID(h http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := h.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// unwrapAll returns the innermost error of the chain.
+func unwrapAll(err error) error {
+	for {
+		next := errors.Unwrap(err)
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
+
+// requestBody returns a copy of the JSON request body, or nil if it is
+// larger than maxLoggedBody. Upload bodies are never read.
+func requestBody(r *http.Request) []byte {
+	if r.GetBody == nil || !isJSON(r.Header) || isUpload(r.Context()) || r.ContentLength > maxLoggedBody {
+		return nil
+	}
+	rc, err := r.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer rc.Close()
+
+	b, err := io.ReadAll(io.LimitReader(rc, maxLoggedBody+1))
+	if err != nil || len(b) > maxLoggedBody {
+		return nil
+	}
+	return bytes.TrimSpace(b)
+}
+
+func isJSON(h http.Header) bool {
+	return strings.HasPrefix(h.Get("Content-Type"), "application/json")
+}
+
+// redactHeaders returns a copy of the headers with credentials redacted.
+func redactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	for name := range out {
+		if isSensitive(name) {
+			out.Set(name, redacted)
+		}
+	}
+	return out
+}
+
+// redactBody replaces values of sensitive fields in the JSON body.
+// Bodies that are not valid JSON are not logged.
+func redactBody(body []byte) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return []byte(redacted)
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return []byte(redacted)
+	}
+	return b
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		// JSON Patch operations carry the field name in the path.
+		if path, ok := v["path"].(string); ok {
+			if _, hasValue := v["value"]; hasValue && isSensitivePath(path) {
+				v["value"] = redacted
+			}
+		}
+		for k, val := range v {
+			if isSensitive(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redactValue(val)
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+	return v
+}
+
+// isSensitivePath reports whether the JSON Patch path
+// points to or into a sensitive field.
+func isSensitivePath(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		if isSensitive(segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSensitive reports whether the field or header is sensitive, whatever
+// its case and separators, e.g. "clientSecret", "client_secret" or "Client-Secret".
+func isSensitive(name string) bool {
+	return sensitiveFields[fieldSeparators.Replace(strings.ToLower(name))]
+}
@@ -0,0 +1,212 @@
+package crowdin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupLoggingClient(t *testing.T, level slog.Level) (*Client, *http.ServeMux, *bytes.Buffer, func()) {
+	t.Helper()
+
+	client, mux, teardown := setupClient()
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: level}))
+	require.NoError(t, WithLogger(logger)(client))
+
+	return client, mux, buf, teardown
+}
+
+func decodeLogRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var rec map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+		records = append(records, rec)
+	}
+	return records
+}
+
+func TestWithLogger_info(t *testing.T) {
+	client, mux, buf, teardown := setupLoggingClient(t, slog.LevelInfo)
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Crowdin-Request-Id", "req-1")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+
+	records := decodeLogRecords(t, buf)
+	require.Len(t, records, 1)
+
+	rec := records[0]
+	assert.Equal(t, "INFO", rec["level"])
+	assert.Equal(t, "crowdin: api request", rec["msg"])
+	assert.Equal(t, "GET", rec["method"])
+	assert.Equal(t, "/api/v2/storages/1", rec["path"])
+	assert.EqualValues(t, 200, rec["status"])
+	assert.Equal(t, "req-1", rec["request_id"])
+	assert.Equal(t, "StorageService.Get", rec["operation"])
+	assert.Contains(t, rec, "duration")
+	assert.NotContains(t, rec, "request_headers")
+	assert.NotContains(t, rec, "response_body")
+}
+
+func TestWithLogger_error(t *testing.T) {
+	client, mux, buf, teardown := setupLoggingClient(t, slog.LevelInfo)
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error": {"code": 404, "message": "Storage Not Found"}}`, http.StatusNotFound)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.Error(t, err)
+
+	records := decodeLogRecords(t, buf)
+	require.Len(t, records, 1)
+	assert.Equal(t, "WARN", records[0]["level"])
+	assert.EqualValues(t, 404, records[0]["status"])
+	assert.Equal(t, "*model.ErrorResponse", records[0]["error_type"])
+}
+
+func TestWithLogger_debugRedactsSecrets(t *testing.T) {
+	client, mux, buf, teardown := setupLoggingClient(t, slog.LevelDebug)
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/users/1/ai/providers", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"id": 2, "name": "OpenAI", "credentials": {"apiKey": "response-secret"}}}`)
+	})
+
+	req := &model.ProviderAddRequest{
+		Name:        "OpenAI",
+		Type:        "open_ai",
+		Credentials: map[string]string{"apiKey": "request-secret"},
+	}
+	_, _, err := client.AI.AddProvider(context.Background(), 1, req)
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.NotContains(t, out, "access_token")
+	assert.NotContains(t, out, "request-secret")
+	assert.NotContains(t, out, "response-secret")
+
+	records := decodeLogRecords(t, buf)
+	require.Len(t, records, 1)
+
+	rec := records[0]
+	assert.Equal(t, []any{redacted}, rec["request_headers"].(map[string]any)["Authorization"])
+	assert.JSONEq(t, `{"name":"OpenAI","type":"open_ai","credentials":"[REDACTED]","config":{"actionRules":null}}`, rec["request_body"].(string))
+	assert.JSONEq(t, `{"data":{"id":2,"name":"OpenAI","credentials":"[REDACTED]"}}`, rec["response_body"].(string))
+}
+
+func TestWithLogger_debugSkipsUploadAndLargeBodies(t *testing.T) {
+	client, mux, buf, teardown := setupLoggingClient(t, slog.LevelDebug)
+	defer teardown()
+
+	large := `{"text":"` + strings.Repeat("a", maxLoggedBody) + `"}`
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+	mux.HandleFunc("/api/v2/projects/1/strings", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"id": 2, "text": "`+strings.Repeat("b", maxLoggedBody)+`"}}`)
+	})
+
+	_, _, err := client.Storages.AddReader(context.Background(), "strings.json", strings.NewReader(`{"key": "upload-content"}`), nil)
+	require.NoError(t, err)
+	_, err = client.Post(context.Background(), "/api/v2/projects/1/strings", json.RawMessage(large), nil)
+	require.NoError(t, err)
+
+	assert.NotContains(t, buf.String(), "upload-content")
+
+	records := decodeLogRecords(t, buf)
+	require.Len(t, records, 2)
+	for _, rec := range records {
+		assert.NotContains(t, rec, "request_body", rec["path"])
+	}
+	assert.Contains(t, records[0], "response_body")
+	assert.NotContains(t, records[1], "response_body")
+}
+
+func TestWithLogger_nil(t *testing.T) {
+	_, err := NewClient("token", WithLogger(nil))
+	require.EqualError(t, err, "logger cannot be nil")
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "webhook headers",
+			body: `{"name":"hook","headers":{"X-Secret":"abc"}}`,
+			want: `{"name":"hook","headers":"[REDACTED]"}`,
+		},
+		{
+			name: "nested fields",
+			body: `{"data":[{"data":{"id":1,"token":"abc","Password":"p"}}]}`,
+			want: `{"data":[{"data":{"id":1,"token":"[REDACTED]","Password":"[REDACTED]"}}]}`,
+		},
+		{
+			name: "json patch",
+			body: `[{"op":"replace","path":"/credentials/apiKey","value":"abc"},{"op":"replace","path":"/name","value":"n"}]`,
+			want: `[{"op":"replace","path":"/credentials/apiKey","value":"[REDACTED]"},{"op":"replace","path":"/name","value":"n"}]`,
+		},
+		{
+			name: "snake case",
+			body: `{"client_secret":"abc","access_token":"def","refresh-token":"ghi","client_id":"id"}`,
+			want: `{"client_secret":"[REDACTED]","access_token":"[REDACTED]","refresh-token":"[REDACTED]","client_id":"id"}`,
+		},
+		{
+			name: "snake case json patch",
+			body: `[{"op":"replace","path":"/config/private_key","value":"abc"}]`,
+			want: `[{"op":"replace","path":"/config/private_key","value":"[REDACTED]"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.JSONEq(t, tt.want, string(redactBody([]byte(tt.body))))
+		})
+	}
+
+	assert.Equal(t, redacted, string(redactBody([]byte("not json"))))
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer token")
+	h.Set("Content-Type", "application/json")
+	h.Set("Private_Key", "key")
+	h.Set("Set-Cookie", "session=1")
+
+	got := redactHeaders(h)
+	assert.Equal(t, redacted, got.Get("Authorization"))
+	assert.Equal(t, redacted, got.Get("Private_Key"))
+	assert.Equal(t, redacted, got.Get("Set-Cookie"))
+	assert.Equal(t, "application/json", got.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", h.Get("Authorization"))
+}
@@ -21,11 +21,12 @@ type MachineTranslationEnginesService struct {
 // GetMT returns a specific machine translation by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.mts.get
-func (s *MachineTranslationEnginesService) GetMT(ctx context.Context, mtID int) (
+func (s *MachineTranslationEnginesService) GetMT(ctx context.Context, mtID int, reqOpts ...RequestOption) (
 	*model.MachineTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "MachineTranslationEnginesService.GetMT")
 	res := new(model.MachineTranslationsResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -33,11 +34,12 @@ func (s *MachineTranslationEnginesService) GetMT(ctx context.Context, mtID int)
 // ListMT returns a list of all machine translations.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.mts.getMany
-func (s *MachineTranslationEnginesService) ListMT(ctx context.Context, opts *model.MTListOptions) (
+func (s *MachineTranslationEnginesService) ListMT(ctx context.Context, opts *model.MTListOptions, reqOpts ...RequestOption) (
 	[]*model.MachineTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "MachineTranslationEnginesService.ListMT")
 	res := new(model.MachineTranslationsListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/mts", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/mts", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -53,11 +55,12 @@ func (s *MachineTranslationEnginesService) ListMT(ctx context.Context, opts *mod
 // AddMT creates a new machine translation.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.mts.post
-func (s *MachineTranslationEnginesService) AddMT(ctx context.Context, req *model.MTAddRequest) (
+func (s *MachineTranslationEnginesService) AddMT(ctx context.Context, req *model.MTAddRequest, reqOpts ...RequestOption) (
 	*model.MachineTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "MachineTranslationEnginesService.AddMT")
 	res := new(model.MachineTranslationsResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/mts", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/mts", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -71,11 +74,12 @@ func (s *MachineTranslationEnginesService) AddMT(ctx context.Context, req *model
 //   - value (any): New value for the field. Value must be one of string or object.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.mts.patch
-func (s *MachineTranslationEnginesService) EditMT(ctx context.Context, mtID int, req []*model.UpdateRequest) (
+func (s *MachineTranslationEnginesService) EditMT(ctx context.Context, mtID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.MachineTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "MachineTranslationEnginesService.EditMT")
 	res := new(model.MachineTranslationsResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -83,18 +87,20 @@ func (s *MachineTranslationEnginesService) EditMT(ctx context.Context, mtID int,
 // DeleteMT removes an existing machine translation.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.mts.delete
-func (s *MachineTranslationEnginesService) DeleteMT(ctx context.Context, mtID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID), nil)
+func (s *MachineTranslationEnginesService) DeleteMT(ctx context.Context, mtID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "MachineTranslationEnginesService.DeleteMT")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/mts/%d", mtID), nil, reqOpts...)
 }
 
 // Translate translates strings using a specific MTE.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.mts.translations.post
-func (s *MachineTranslationEnginesService) Translate(ctx context.Context, mtID int, req *model.TranslateRequest) (
+func (s *MachineTranslationEnginesService) Translate(ctx context.Context, mtID int, req *model.TranslateRequest, reqOpts ...RequestOption) (
 	*model.MTTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "MachineTranslationEnginesService.Translate")
 	res := new(model.MTTranslationResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/mts/%d/translations", mtID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/mts/%d/translations", mtID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -5,14 +5,55 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
 var (
 	// ErrNilRequest is returned when a request for a validation is nil.
 	ErrNilRequest = errors.New("request cannot be nil")
+
+	// ErrNotFound is matched by the errors of 404 Not Found responses.
+	ErrNotFound = errors.New("not found")
+	// ErrUnauthorized is matched by the errors of 401 Unauthorized responses.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is matched by the errors of 403 Forbidden responses.
+	ErrForbidden = errors.New("forbidden")
+	// ErrRateLimited is matched by the errors of 429 Too Many Requests responses.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrConflict is matched by the errors of 409 Conflict responses.
+	ErrConflict = errors.New("conflict")
+	// ErrServerError is matched by the errors of 5xx responses.
+	ErrServerError = errors.New("server error")
 )
 
+// statusError returns the sentinel error for the status code.
+func statusError(code int) error {
+	switch {
+	case code == http.StatusNotFound:
+		return ErrNotFound
+	case code == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case code == http.StatusForbidden:
+		return ErrForbidden
+	case code == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case code == http.StatusConflict:
+		return ErrConflict
+	case code >= http.StatusInternalServerError && code <= 599:
+		return ErrServerError
+	default:
+		return nil
+	}
+}
+
+// isStatusError reports whether the target is the sentinel error
+// for the status code.
+func isStatusError(code int, target error) bool {
+	sentinel := statusError(code)
+	return sentinel != nil && sentinel == target
+}
+
 // Error represents the schema for the error response.
 type Error struct {
 	Code    any    `json:"code"`
@@ -57,9 +98,30 @@ type ErrorResponse struct {
 
 // Error implements the Error interface.
 func (r *ErrorResponse) Error() string {
+	if r.Err.Code == nil && r.Err.Message == "" {
+		return fmt.Sprintf("client: server returned %d status code", r.StatusCode())
+	}
 	return fmt.Sprintf("%d %s", r.Err.Code, r.Err.Message)
 }
 
+// StatusCode returns the HTTP status code of the response. If the
+// response is not set, the error code is returned.
+func (r *ErrorResponse) StatusCode() int {
+	if r.Response != nil {
+		return r.Response.StatusCode
+	}
+	if code, ok := r.Err.Code.(int); ok {
+		return code
+	}
+	return 0
+}
+
+// Is reports whether the target is the sentinel error
+// for the status code of the response, e.g. ErrNotFound.
+func (r *ErrorResponse) Is(target error) bool {
+	return isStatusError(r.StatusCode(), target)
+}
+
 // ValidationError represents the schema for the invalid
 // request error response.
 type ValidationError struct {
@@ -114,6 +176,69 @@ func (r *ValidationErrorResponse) Error() string {
 	return sb.String()
 }
 
+// Is reports whether the target is the sentinel error
+// for the status code of the response.
+func (r *ValidationErrorResponse) Is(target error) bool {
+	return isStatusError(r.Status, target)
+}
+
+// FieldErrors returns the key/code pairs of the validation errors.
+func (r *ValidationErrorResponse) FieldErrors() []FieldError {
+	return fieldErrors(-1, r.Errors)
+}
+
+// FieldError is a key/code pair of a validation error.
+type FieldError struct {
+	// Index is the index of the invalid item of a batch
+	// request, or -1 for non-batch requests.
+	Index   int
+	Key     string
+	Code    string
+	Message string
+}
+
+// fieldErrors flattens the validation errors into key/code pairs.
+func fieldErrors(index int, errs []ValidationError) []FieldError {
+	var fields []FieldError
+	for _, e := range errs {
+		for _, err := range e.ErrorDetail.Errors {
+			fields = append(fields, FieldError{
+				Index:   index,
+				Key:     e.ErrorDetail.Key,
+				Code:    errorCode(err.Code),
+				Message: err.Message,
+			})
+		}
+	}
+	return fields
+}
+
+// errorCode converts the error code to a string.
+func errorCode(code any) string {
+	switch v := code.(type) {
+	case int:
+		return strconv.Itoa(v)
+	case string:
+		return v
+	default:
+		return ""
+	}
+}
+
+// FieldErrors returns the key/code pairs of the validation errors
+// in the err's chain. It returns nil if err is not a validation error.
+func FieldErrors(err error) []FieldError {
+	var validationErr *ValidationErrorResponse
+	if errors.As(err, &validationErr) {
+		return validationErr.FieldErrors()
+	}
+	var batchErr *BatchValidationErrorResponse
+	if errors.As(err, &batchErr) {
+		return batchErr.FieldErrors()
+	}
+	return nil
+}
+
 // BatchValidationError represents the invalid batch request
 // error response schema (string-based API).
 type BatchValidationError struct {
@@ -155,6 +280,22 @@ func (r *BatchValidationErrorResponse) Error() string {
 	return sb.String()
 }
 
+// Is reports whether the target is the sentinel error
+// for the status code of the response.
+func (r *BatchValidationErrorResponse) Is(target error) bool {
+	return isStatusError(r.Status, target)
+}
+
+// FieldErrors returns the key/code pairs of the validation
+// errors of all the invalid items.
+func (r *BatchValidationErrorResponse) FieldErrors() []FieldError {
+	var fields []FieldError
+	for _, e := range r.Errors {
+		fields = append(fields, fieldErrors(e.Index, e.Errors)...)
+	}
+	return fields
+}
+
 // GraphQLError represents a single GraphQL error.
 type GraphQLError struct {
 	Message    string         `json:"message"`
@@ -3,6 +3,7 @@ package model
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -442,3 +443,45 @@ func determineErrorType(resp *http.Response, body []byte, graph bool) error {
 		return &ErrorResponse{Response: resp}
 	}
 }
+
+func TestErrorResponse_Is(t *testing.T) {
+	err := &ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrForbidden)
+	assert.Equal(t, "client: server returned 404 status code", err.Error())
+
+	err = &ErrorResponse{Err: Error{Code: 503, Message: "Service Unavailable"}}
+	assert.ErrorIs(t, fmt.Errorf("wrapped: %w", err), ErrServerError)
+
+	assert.NotErrorIs(t, &ValidationErrorResponse{Status: http.StatusBadRequest}, ErrNotFound)
+	assert.ErrorIs(t, &ValidationErrorResponse{Status: http.StatusConflict}, ErrConflict)
+}
+
+func TestFieldErrors(t *testing.T) {
+	var validationErr ValidationErrorResponse
+	err := json.Unmarshal([]byte(`{"errors": [
+		{"error": {"key": "name", "errors": [{"code": "isEmpty", "message": "Value is required"}]}},
+		{"error": {"key": "languageId", "errors": [{"code": 1, "message": "Invalid"}, {"code": "notFound", "message": "Not found"}]}}
+	]}`), &validationErr)
+	assert.NoError(t, err)
+
+	expected := []FieldError{
+		{Index: -1, Key: "name", Code: "isEmpty", Message: "Value is required"},
+		{Index: -1, Key: "languageId", Code: "1", Message: "Invalid"},
+		{Index: -1, Key: "languageId", Code: "notFound", Message: "Not found"},
+	}
+	assert.Equal(t, expected, validationErr.FieldErrors())
+	assert.Equal(t, expected, FieldErrors(fmt.Errorf("wrapped: %w", &validationErr)))
+
+	var batchErr BatchValidationErrorResponse
+	err = json.Unmarshal([]byte(`{"errors": [
+		{"index": 2, "errors": [{"error": {"key": "text", "errors": [{"code": "stringLengthTooLong", "message": "Too long"}]}}]}
+	]}`), &batchErr)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []FieldError{
+		{Index: 2, Key: "text", Code: "stringLengthTooLong", Message: "Too long"},
+	}, FieldErrors(&batchErr))
+
+	assert.Nil(t, FieldErrors(errors.New("other error")))
+}
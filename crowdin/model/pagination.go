@@ -32,6 +32,12 @@ func (o *ListOptions) Values() (url.Values, bool) {
 	return v, len(v) > 0
 }
 
+// PageOptions returns the pagination options. It is promoted to the list
+// options embedding ListOptions and is used by the paginated iterators.
+func (o *ListOptions) PageOptions() *ListOptions {
+	return o
+}
+
 // Pagination represents the pagination information.
 type Pagination struct {
 	Offset int `json:"offset,omitempty"`
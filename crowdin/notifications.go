@@ -39,8 +39,9 @@ type NotificationsService struct {
 //	}
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.notify.post
-func (s *NotificationsService) Notify(ctx context.Context, req *model.Notification) (*Response, error) {
-	return s.client.Post(ctx, "/api/v2/notify", req, nil)
+func (s *NotificationsService) Notify(ctx context.Context, req *model.Notification, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "NotificationsService.Notify")
+	return s.client.Post(ctx, "/api/v2/notify", req, nil, reqOpts...)
 }
 
 // NotifyProjectMembers sends a notification to project members.
@@ -50,8 +51,9 @@ func (s *NotificationsService) Notify(ctx context.Context, req *model.Notificati
 // To send by role, pass the request body with the `Role` and `Message`.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.notify.post
-func (s *NotificationsService) NotifyProjectMembers(ctx context.Context, projectID int, req *model.Notification) (
+func (s *NotificationsService) NotifyProjectMembers(ctx context.Context, projectID int, req *model.Notification, reqOpts ...RequestOption) (
 	*Response, error,
 ) {
-	return s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/notify", projectID), req, nil)
+	ctx = withOperation(ctx, "NotificationsService.NotifyProjectMembers")
+	return s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/notify", projectID), req, nil, reqOpts...)
 }
@@ -0,0 +1,224 @@
+package crowdin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const accountsURL = "https://accounts.crowdin.com/"
+
+// OAuthConfig describes a Crowdin OAuth application and
+// implements the authorization code and refresh token flows.
+//
+// Crowdin OAuth docs:
+// https://support.crowdin.com/developer/authorizing-oauth-apps/
+type OAuthConfig struct {
+	// ClientID is the application's client ID.
+	ClientID string
+	// ClientSecret is the application's client secret.
+	ClientSecret string
+	// RedirectURL is the URL to redirect users to after
+	// they authorize the application.
+	RedirectURL string
+	// Scopes specifies the requested permissions (e.g. "project", "tm").
+	Scopes []string
+	// AccountsURL is the base URL of the Crowdin accounts server.
+	// Defaults to https://accounts.crowdin.com/.
+	AccountsURL string
+	// HTTPClient is used for the token requests.
+	// If not set http.DefaultClient will be used.
+	HTTPClient *http.Client
+}
+
+// AuthCodeURL returns the URL of the consent page that asks the user
+// for the permissions. `state` is an opaque value used to protect
+// against CSRF attacks.
+func (c *OAuthConfig) AuthCodeURL(state string) string {
+	v := url.Values{
+		"client_id":     {c.ClientID},
+		"response_type": {"code"},
+	}
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if len(c.Scopes) > 0 {
+		v.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	return c.endpoint("oauth/authorize") + "?" + v.Encode()
+}
+
+// Exchange converts the authorization code received on
+// the redirect URL into a token.
+func (c *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	if code == "" {
+		return nil, errors.New("code cannot be empty")
+	}
+
+	return c.retrieveToken(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"redirect_uri":  c.RedirectURL,
+		"code":          code,
+	})
+}
+
+// Refresh obtains a new token using the refresh token.
+func (c *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, errors.New("refresh token cannot be empty")
+	}
+
+	return c.retrieveToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": refreshToken,
+	})
+}
+
+// TokenSource returns a TokenSource that returns `t` until it expires and
+// then automatically refreshes it using its refresh token. The context is
+// used for the refresh requests.
+func (c *OAuthConfig) TokenSource(ctx context.Context, t *Token) TokenSource {
+	return &oauthTokenSource{ctx: ctx, conf: c, t: t}
+}
+
+type oauthTokenSource struct {
+	ctx  context.Context
+	conf *OAuthConfig
+
+	mu sync.Mutex
+	t  *Token
+	// refreshedFor is the access token a refresh was last forced for.
+	refreshedFor string
+}
+
+// Token returns the current token if it is valid,
+// otherwise the token is refreshed.
+func (s *oauthTokenSource) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.t.Valid() && s.refreshedFor != s.t.AccessToken {
+		return s.t, nil
+	}
+	if s.t == nil || s.t.RefreshToken == "" {
+		return nil, errors.New("oauth: token expired and refresh token is not set")
+	}
+
+	t, err := s.conf.Refresh(s.ctx, s.t.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	// Some servers do not rotate refresh tokens.
+	if t.RefreshToken == "" {
+		t.RefreshToken = s.t.RefreshToken
+	}
+	s.t = t
+	return t, nil
+}
+
+// invalidate forces the next Token call to refresh the token.
+// It is called by the client when the token has been rejected.
+func (s *oauthTokenSource) invalidate(rejected *Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.t != nil && rejected != nil && s.t.AccessToken == rejected.AccessToken {
+		s.refreshedFor = rejected.AccessToken
+	}
+}
+
+func (c *OAuthConfig) endpoint(path string) string {
+	base := c.AccountsURL
+	if base == "" {
+		base = accountsURL
+	}
+	return strings.TrimSuffix(base, "/") + "/" + path
+}
+
+// tokenResponse is the response of the token endpoint.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Message          string `json:"message"`
+}
+
+// retrieveToken requests a token from the token endpoint.
+func (c *OAuthConfig) retrieveToken(ctx context.Context, params map[string]string) (*Token, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint("oauth/token"), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	hc := c.HTTPClient
+	if hc == nil {
+		hc = http.DefaultClient
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oauth: cannot fetch token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("oauth: cannot fetch token: %w", err)
+	}
+
+	tr := new(tokenResponse)
+	if err := json.Unmarshal(b, tr); err != nil && resp.StatusCode < http.StatusBadRequest {
+		return nil, fmt.Errorf("oauth: cannot parse token response: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest || tr.AccessToken == "" {
+		msg := tr.ErrorDescription
+		if msg == "" {
+			msg = tr.Message
+		}
+		if msg == "" {
+			msg = tr.Error
+		}
+		if msg == "" {
+			msg = http.StatusText(resp.StatusCode)
+		}
+		return nil, fmt.Errorf("oauth: token request failed with %d status code: %s", resp.StatusCode, msg)
+	}
+
+	t := &Token{
+		AccessToken:  tr.AccessToken,
+		TokenType:    tr.TokenType,
+		RefreshToken: tr.RefreshToken,
+	}
+	if tr.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	return t, nil
+}
@@ -0,0 +1,171 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupOAuth(t *testing.T, handler http.HandlerFunc) *OAuthConfig {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, "Content-Type", "application/json")
+		handler(w, r)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return &OAuthConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://example.com/callback",
+		Scopes:       []string{"project", "tm"},
+		AccountsURL:  server.URL,
+	}
+}
+
+func TestOAuthConfig_AuthCodeURL(t *testing.T) {
+	conf := &OAuthConfig{
+		ClientID:    "client-id",
+		RedirectURL: "https://example.com/callback",
+		Scopes:      []string{"project", "tm"},
+	}
+
+	u, err := url.Parse(conf.AuthCodeURL("state"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://accounts.crowdin.com/oauth/authorize", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(t, url.Values{
+		"client_id":     {"client-id"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"project tm"},
+		"state":         {"state"},
+	}, u.Query())
+
+	conf.AccountsURL = "https://accounts.example.com/"
+	assert.Contains(t, conf.AuthCodeURL(""), "https://accounts.example.com/oauth/authorize?")
+}
+
+func TestOAuthConfig_Exchange(t *testing.T) {
+	conf := setupOAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		testJSONBody(t, r, `{
+			"grant_type": "authorization_code",
+			"client_id": "client-id",
+			"client_secret": "client-secret",
+			"redirect_uri": "https://example.com/callback",
+			"code": "auth-code"
+		}`)
+		fmt.Fprint(w, `{"access_token": "access", "token_type": "bearer", "expires_in": 7200, "refresh_token": "refresh"}`)
+	})
+
+	token, err := conf.Exchange(context.Background(), "auth-code")
+	require.NoError(t, err)
+
+	assert.Equal(t, "access", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.Equal(t, "Bearer", token.Type())
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), token.Expiry, time.Minute)
+
+	_, err = conf.Exchange(context.Background(), "")
+	require.EqualError(t, err, "code cannot be empty")
+}
+
+func TestOAuthConfig_Refresh(t *testing.T) {
+	conf := setupOAuth(t, func(w http.ResponseWriter, r *http.Request) {
+		testJSONBody(t, r, `{
+			"grant_type": "refresh_token",
+			"client_id": "client-id",
+			"client_secret": "client-secret",
+			"refresh_token": "refresh"
+		}`)
+		fmt.Fprint(w, `{"access_token": "new-access", "token_type": "bearer", "expires_in": 7200, "refresh_token": "new-refresh"}`)
+	})
+
+	token, err := conf.Refresh(context.Background(), "refresh")
+	require.NoError(t, err)
+	assert.Equal(t, "new-access", token.AccessToken)
+	assert.Equal(t, "new-refresh", token.RefreshToken)
+
+	_, err = conf.Refresh(context.Background(), "")
+	require.EqualError(t, err, "refresh token cannot be empty")
+}
+
+func TestOAuthConfig_errorResponse(t *testing.T) {
+	conf := setupOAuth(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": "invalid_grant", "error_description": "The authorization code is invalid"}`)
+	})
+
+	_, err := conf.Exchange(context.Background(), "code")
+	require.EqualError(t, err, "oauth: token request failed with 400 status code: The authorization code is invalid")
+}
+
+func TestOAuthConfig_TokenSource(t *testing.T) {
+	var refreshes int32
+	conf := setupOAuth(t, func(w http.ResponseWriter, _ *http.Request) {
+		n := atomic.AddInt32(&refreshes, 1)
+		fmt.Fprintf(w, `{"access_token": "access-%d", "expires_in": 3600}`, n)
+	})
+
+	ts := conf.TokenSource(context.Background(), &Token{
+		AccessToken:  "expired",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(-time.Minute),
+	})
+
+	token, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "access-1", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken, "refresh token is kept if not rotated")
+
+	token, err = ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "access-1", token.AccessToken)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&refreshes))
+
+	ts = conf.TokenSource(context.Background(), &Token{AccessToken: "expired", Expiry: time.Now().Add(-time.Minute)})
+	_, err = ts.Token()
+	require.EqualError(t, err, "oauth: token expired and refresh token is not set")
+}
+
+func TestOAuthConfig_TokenSource_refreshOnUnauthorized(t *testing.T) {
+	conf := setupOAuth(t, func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"access_token": "fresh", "expires_in": 3600, "refresh_token": "refresh-2"}`)
+	})
+
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	ts := conf.TokenSource(context.Background(), &Token{
+		AccessToken:  "revoked",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(time.Hour),
+	})
+	require.NoError(t, WithTokenSource(ts)(client))
+
+	var got []string
+	mux.HandleFunc("/get", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := client.Get(context.Background(), "/get", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer revoked", "Bearer fresh"}, got)
+}
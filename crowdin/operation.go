@@ -0,0 +1,193 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Statuses of the asynchronous operations.
+const (
+	OperationCreated    = "created"
+	OperationInProgress = "inProgress"
+	OperationFinished   = "finished"
+	OperationFailed     = "failed"
+	OperationCanceled   = "canceled"
+)
+
+var (
+	// ErrOperationFailed is matched by the OperationError
+	// of an operation that has failed.
+	ErrOperationFailed = errors.New("operation failed")
+	// ErrOperationCanceled is matched by the OperationError
+	// of an operation that has been canceled.
+	ErrOperationCanceled = errors.New("operation canceled")
+)
+
+// OperationError is returned when an operation has failed or has been canceled.
+type OperationError struct {
+	// Status is the terminal status of the operation.
+	Status string
+	// Progress is the progress of the operation in percent.
+	Progress int
+	// Result is the last polled status of the operation,
+	// e.g. *model.TranslationsProjectBuild.
+	Result any
+}
+
+// Error implements the Error interface.
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("crowdin: operation %s at %d%%", e.Status, e.Progress)
+}
+
+// Is reports whether the target is ErrOperationFailed or
+// ErrOperationCanceled matching the status of the operation.
+func (e *OperationError) Is(target error) bool {
+	switch target {
+	case ErrOperationFailed:
+		return e.Status == OperationFailed
+	case ErrOperationCanceled:
+		return isCanceled(e.Status)
+	default:
+		return false
+	}
+}
+
+func isCanceled(status string) bool {
+	return status == OperationCanceled || status == "cancelled"
+}
+
+// Operation is an asynchronous operation running on the server, such as
+// a translations build, a file export or a branch merge. It is created by
+// the ...Operation methods of the services and polls the status of the
+// operation until it reaches a terminal state.
+type Operation[T any] struct {
+	poll   func(ctx context.Context) (*T, *Response, error)
+	status func(*T) (string, int)
+}
+
+// newOperation returns an operation polled with the status check
+// function. The status function returns the status and the progress
+// of the operation.
+func newOperation[T any](poll func(ctx context.Context) (*T, *Response, error), status func(*T) (string, int)) *Operation[T] {
+	return &Operation[T]{poll: poll, status: status}
+}
+
+// PollOption configures how an operation is polled.
+type PollOption func(*pollConfig)
+
+type pollConfig struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	multiplier  float64
+	onProgress  func(status string, progress int)
+}
+
+// PollInterval sets the interval between the status checks. Defaults to 1 second.
+func PollInterval(d time.Duration) PollOption {
+	return func(c *pollConfig) {
+		if d > 0 {
+			c.interval = d
+		}
+	}
+}
+
+// PollBackoff makes the interval between the status checks grow by the
+// multiplier after every check up to the max interval. Defaults to 1.5
+// and 15 seconds. A multiplier of 1 keeps the interval constant.
+func PollBackoff(multiplier float64, maxInterval time.Duration) PollOption {
+	return func(c *pollConfig) {
+		if multiplier >= 1 {
+			c.multiplier = multiplier
+		}
+		if maxInterval > 0 {
+			c.maxInterval = maxInterval
+		}
+	}
+}
+
+// OnProgress sets the function called with the status
+// and the progress of the operation after every check.
+func OnProgress(fn func(status string, progress int)) PollOption {
+	return func(c *pollConfig) {
+		c.onProgress = fn
+	}
+}
+
+// Poll checks the status of the operation once. It reports whether the
+// operation has reached a terminal state. If the operation has failed or
+// has been canceled, the error is an *OperationError.
+func (o *Operation[T]) Poll(ctx context.Context) (result *T, done bool, err error) {
+	result, _, done, err = o.check(ctx)
+	return result, done, err
+}
+
+func (o *Operation[T]) check(ctx context.Context) (*T, *Response, bool, error) {
+	result, resp, err := o.poll(ctx)
+	if err != nil {
+		return result, resp, false, err
+	}
+	if result == nil {
+		return nil, resp, false, errors.New("crowdin: operation status is empty")
+	}
+
+	status, progress := o.status(result)
+	switch {
+	case status == OperationFinished:
+		return result, resp, true, nil
+	case status == OperationFailed || isCanceled(status):
+		return result, resp, true, &OperationError{Status: status, Progress: progress, Result: result}
+	default:
+		return result, resp, false, nil
+	}
+}
+
+// Wait polls the status of the operation until it is finished, has
+// failed or has been canceled, or the context is done. It returns the
+// last polled status of the operation. The Retry-After header of the
+// status responses is honored.
+func (o *Operation[T]) Wait(ctx context.Context, opts ...PollOption) (*T, error) {
+	cfg := &pollConfig{
+		interval:    time.Second,
+		maxInterval: 15 * time.Second,
+		multiplier:  1.5,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	interval, maxInterval := cfg.interval, max(cfg.interval, cfg.maxInterval)
+
+	var last *T
+	for {
+		result, resp, done, err := o.check(ctx)
+		if err != nil && !done {
+			if result == nil {
+				result = last
+			}
+			return result, err
+		}
+		last = result
+		if cfg.onProgress != nil {
+			cfg.onProgress(o.status(result))
+		}
+		if done {
+			return result, err
+		}
+
+		wait := interval
+		if resp != nil && resp.RetryAfter > wait {
+			wait = resp.RetryAfter
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = min(time.Duration(float64(interval)*cfg.multiplier), maxInterval)
+	}
+}
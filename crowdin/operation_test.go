@@ -0,0 +1,261 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// handleStatuses serves the statuses in order, repeating the last one.
+func handleStatuses(t *testing.T, mux *http.ServeMux, path string, statuses ...string) *int {
+	t.Helper()
+
+	calls := 0
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		status := statuses[min(calls, len(statuses)-1)]
+		calls++
+		fmt.Fprintf(w, `{"data": {"id": 1, "identifier": "id", "status": %q, "progress": %d}}`, status, calls*10)
+	})
+	return &calls
+}
+
+func TestOperation_Wait(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	calls := handleStatuses(t, mux, "/api/v2/projects/1/translations/builds/2", "created", "inProgress", "finished")
+
+	var progress []string
+	build, err := client.Translations.BuildOperation(1, 2).Wait(context.Background(),
+		PollInterval(time.Millisecond),
+		OnProgress(func(status string, p int) {
+			progress = append(progress, fmt.Sprintf("%s %d", status, p))
+		}),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "finished", build.Status)
+	assert.Equal(t, 3, *calls)
+	assert.Equal(t, []string{"created 10", "inProgress 20", "finished 30"}, progress)
+}
+
+func TestOperation_Wait_failed(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleStatuses(t, mux, "/api/v2/projects/1/branches/2/merges/id", "inProgress", "failed")
+
+	merge, err := client.Branches.MergeOperation(1, 2, "id").Wait(context.Background(), PollInterval(time.Millisecond))
+	require.ErrorIs(t, err, ErrOperationFailed)
+	assert.NotErrorIs(t, err, ErrOperationCanceled)
+	assert.EqualError(t, err, "crowdin: operation failed at 20%")
+
+	var opErr *OperationError
+	require.ErrorAs(t, err, &opErr)
+	assert.Equal(t, merge, opErr.Result)
+	assert.Equal(t, "failed", merge.Status)
+}
+
+func TestOperation_Wait_canceled(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	for i, status := range []string{"canceled", "cancelled"} {
+		path := fmt.Sprintf("/api/v2/projects/%d/translations/builds/1", i)
+		handleStatuses(t, mux, path, status)
+
+		_, err := client.Translations.BuildOperation(i, 1).Wait(context.Background())
+		assert.ErrorIs(t, err, ErrOperationCanceled, status)
+	}
+}
+
+func TestOperation_Wait_contextDone(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The context is canceled by the third poll, whether or not
+	// its response reaches the client.
+	calls := 0
+	mux.HandleFunc("/api/v2/projects/1/translations/builds/2", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		fmt.Fprintf(w, `{"data": {"id": 2, "status": "inProgress", "progress": %d}}`, calls*10)
+		if calls == 3 {
+			cancel()
+		}
+	})
+
+	build, err := client.Translations.BuildOperation(1, 2).Wait(ctx, PollInterval(time.Millisecond), PollBackoff(1, 0))
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotNil(t, build)
+	assert.Equal(t, "inProgress", build.Status)
+	assert.Equal(t, 3, calls)
+}
+
+func TestOperation_Wait_requestError(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1/translations/builds/2", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error": {"code": 404, "message": "Build Not Found"}}`, http.StatusNotFound)
+	})
+
+	_, err := client.Translations.BuildOperation(1, 2).Wait(context.Background())
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestOperation_Wait_emptyStatus(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/projects/1/translations/builds/2", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": null}`)
+	})
+
+	_, err := client.Translations.BuildOperation(1, 2).Wait(context.Background())
+	require.EqualError(t, err, "crowdin: operation status is empty")
+}
+
+func TestOperation_Wait_backoff(t *testing.T) {
+	var times []time.Time
+	op := newOperation(func(context.Context) (*model.BranchMerge, *Response, error) {
+		times = append(times, time.Now())
+		status := "inProgress"
+		if len(times) == 4 {
+			status = "finished"
+		}
+		return &model.BranchMerge{Status: status}, &Response{}, nil
+	}, func(v *model.BranchMerge) (string, int) { return v.Status, v.Progress })
+
+	_, err := op.Wait(context.Background(), PollInterval(10*time.Millisecond), PollBackoff(2, 30*time.Millisecond))
+	require.NoError(t, err)
+	require.Len(t, times, 4)
+
+	assert.GreaterOrEqual(t, times[1].Sub(times[0]), 10*time.Millisecond)
+	assert.GreaterOrEqual(t, times[2].Sub(times[1]), 20*time.Millisecond)
+	assert.GreaterOrEqual(t, times[3].Sub(times[2]), 30*time.Millisecond)
+}
+
+func TestOperation_Wait_retryAfter(t *testing.T) {
+	var times []time.Time
+	op := newOperation(func(context.Context) (*model.BranchMerge, *Response, error) {
+		times = append(times, time.Now())
+		if len(times) == 2 {
+			return &model.BranchMerge{Status: "finished"}, &Response{}, nil
+		}
+		return &model.BranchMerge{Status: "inProgress"}, &Response{RetryAfter: 50 * time.Millisecond}, nil
+	}, func(v *model.BranchMerge) (string, int) { return v.Status, v.Progress })
+
+	_, err := op.Wait(context.Background(), PollInterval(time.Millisecond))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, times[1].Sub(times[0]), 50*time.Millisecond)
+}
+
+func TestOperation_Poll(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleStatuses(t, mux, "/api/v2/glossaries/1/imports/2", "inProgress", "finished")
+
+	op := client.Glossaries.GlossaryImportOperation(1, 2)
+
+	res, done, err := op.Poll(context.Background())
+	require.NoError(t, err)
+	assert.False(t, done)
+	assert.Equal(t, "inProgress", res.Status)
+
+	res, done, err = op.Poll(context.Background())
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, "finished", res.Status)
+}
+
+func TestOperation_services(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	tests := []struct {
+		path string
+		wait func(ctx context.Context) error
+	}{
+		{"/api/v2/ai/prompts/1/fine-tuning/datasets/id", func(ctx context.Context) error {
+			_, err := client.AI.FineTuningDatasetOperation(1, "id", 0).Wait(ctx)
+			return err
+		}},
+		{"/api/v2/ai/prompts/1/fine-tuning/jobs/id", func(ctx context.Context) error {
+			_, err := client.AI.FineTuningJobOperation(1, "id", 0).Wait(ctx)
+			return err
+		}},
+		{"/api/v2/projects/1/branches/2/clones/id", func(ctx context.Context) error {
+			_, err := client.Branches.CloneOperation(1, 2, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/projects/1/bundles/2/exports/id", func(ctx context.Context) error {
+			_, err := client.Bundles.ExportOperation(1, 2, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/glossaries/1/exports/id", func(ctx context.Context) error {
+			_, err := client.Glossaries.GlossaryExportOperation(1, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/reports/archives/2/exports/id", func(ctx context.Context) error {
+			_, err := client.Reports.ArchiveExportOperation(0, 2, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/projects/1/reports/id", func(ctx context.Context) error {
+			_, err := client.Reports.Operation(1, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/groups/1/reports/id", func(ctx context.Context) error {
+			_, err := client.Reports.GroupReportOperation(1, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/reports/id", func(ctx context.Context) error {
+			_, err := client.Reports.OrganizationReportOperation("id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/projects/1/strings/reviewed-builds/2", func(ctx context.Context) error {
+			_, err := client.SourceFiles.ReviewedBuildOperation(1, 2).Wait(ctx)
+			return err
+		}},
+		{"/api/v2/projects/1/strings/uploads/id", func(ctx context.Context) error {
+			_, err := client.SourceStrings.UploadOperation(1, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/tms/1/exports/id", func(ctx context.Context) error {
+			_, err := client.TranslationMemory.TMExportOperation(1, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/tms/1/imports/id", func(ctx context.Context) error {
+			_, err := client.TranslationMemory.TMImportOperation(1, "id").Wait(ctx)
+			return err
+		}},
+		{"/api/v2/projects/1/pre-translations/id", func(ctx context.Context) error {
+			_, err := client.Translations.PreTranslationOperation(1, "id").Wait(ctx)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		calls := handleStatuses(t, mux, tt.path, "finished")
+		require.NoError(t, tt.wait(context.Background()), tt.path)
+		assert.Equal(t, 1, *calls, tt.path)
+	}
+}
+
+func TestOperationError_Is(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &OperationError{Status: "failed"})
+	assert.True(t, errors.Is(err, ErrOperationFailed))
+	assert.False(t, errors.Is(err, ErrOperationCanceled))
+}
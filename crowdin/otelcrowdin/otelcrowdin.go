@@ -0,0 +1,222 @@
+// Package otelcrowdin provides OpenTelemetry instrumentation for the Crowdin API client.
+//
+// The instrumentation is a crowdin.Middleware that creates a span per API call
+// named after the service method (e.g. "TranslationsService.BuildProjectTranslation")
+// and records request latency, retries and rate-limit waits:
+//
+//	client, err := crowdin.NewClient(token, crowdin.WithMiddleware(otelcrowdin.Middleware()))
+//
+// Only the OpenTelemetry API is used, the SDK has to be configured by the application.
+package otelcrowdin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// ScopeName is the instrumentation scope name.
+const ScopeName = "github.com/crowdin/crowdin-api-client-go/crowdin/otelcrowdin"
+
+// Attribute keys set on spans and metrics.
+const (
+	OperationKey        = attribute.Key("crowdin.operation")
+	ProjectIDKey        = attribute.Key("crowdin.project_id")
+	ErrorCodeKey        = attribute.Key("crowdin.error_code")
+	PaginationOffsetKey = attribute.Key("crowdin.pagination.offset")
+	RetryCountKey       = attribute.Key("crowdin.retry_count")
+	HTTPMethodKey       = attribute.Key("http.request.method")
+	HTTPStatusCodeKey   = attribute.Key("http.response.status_code")
+	ServerAddressKey    = attribute.Key("server.address")
+	URLPathKey          = attribute.Key("url.path")
+)
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// WithTracerProvider sets the tracer provider. If not set
+// the global tracer provider is used.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the meter provider. If not set
+// the global meter provider is used.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = mp
+	}
+}
+
+type instruments struct {
+	tracer        trace.Tracer
+	duration      metric.Float64Histogram
+	retries       metric.Int64Counter
+	rateLimitWait metric.Float64Histogram
+}
+
+// Middleware returns a crowdin.Middleware that traces API calls
+// and records the following metrics:
+//
+//	crowdin.client.request.duration: duration of API calls including retries (s).
+//	crowdin.client.retries: number of retried requests.
+//	crowdin.client.rate_limit.wait: time spent waiting for the client-side rate limiter (s).
+func Middleware(opts ...Option) crowdin.Middleware {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	meter := cfg.meterProvider.Meter(ScopeName)
+	inst := &instruments{tracer: cfg.tracerProvider.Tracer(ScopeName)}
+
+	// Instrument creation errors are reported to the global error
+	// handler and a no-op instrument is returned, so they are ignored.
+	var err error
+	inst.duration, err = meter.Float64Histogram("crowdin.client.request.duration",
+		metric.WithDescription("Duration of Crowdin API calls including retries."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	inst.retries, err = meter.Int64Counter("crowdin.client.retries",
+		metric.WithDescription("Number of retried Crowdin API requests."),
+		metric.WithUnit("{retry}"))
+	if err != nil {
+		otel.Handle(err)
+	}
+	inst.rateLimitWait, err = meter.Float64Histogram("crowdin.client.rate_limit.wait",
+		metric.WithDescription("Time spent waiting for the client-side rate limiter."),
+		metric.WithUnit("s"))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	return inst.middleware
+}
+
+func (inst *instruments) middleware(next crowdin.RoundTripFunc) crowdin.RoundTripFunc {
+	return func(r *http.Request, v any) (*crowdin.Response, error) {
+		info, _ := crowdin.CallInfoFromContext(r.Context())
+		operation := r.Method
+		if info != nil && info.Operation != "" {
+			operation = info.Operation
+		}
+
+		attrs := []attribute.KeyValue{
+			OperationKey.String(operation),
+			HTTPMethodKey.String(r.Method),
+			ServerAddressKey.String(r.URL.Hostname()),
+			URLPathKey.String(r.URL.Path),
+		}
+		if id, ok := projectID(r.URL.Path); ok {
+			attrs = append(attrs, ProjectIDKey.Int(id))
+		}
+
+		ctx, span := inst.tracer.Start(r.Context(), operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		start := time.Now()
+		resp, err := next(r.WithContext(ctx), v)
+		elapsed := time.Since(start).Seconds()
+
+		metricAttrs := []attribute.KeyValue{OperationKey.String(operation)}
+		if resp != nil && resp.Response != nil {
+			span.SetAttributes(HTTPStatusCodeKey.Int(resp.StatusCode))
+			metricAttrs = append(metricAttrs, HTTPStatusCodeKey.Int(resp.StatusCode))
+		}
+		if offset, ok := paginationOffset(r, resp); ok {
+			span.SetAttributes(PaginationOffsetKey.Int(offset))
+		}
+		if info != nil {
+			if retries := info.Attempts - 1; retries > 0 {
+				span.SetAttributes(RetryCountKey.Int(retries))
+				inst.retries.Add(ctx, int64(retries), metric.WithAttributes(metricAttrs...))
+			}
+			if info.RateLimitWait > 0 {
+				inst.rateLimitWait.Record(ctx, info.RateLimitWait.Seconds(), metric.WithAttributes(OperationKey.String(operation)))
+			}
+		}
+		if err != nil {
+			if code, ok := errorCode(err); ok {
+				span.SetAttributes(ErrorCodeKey.String(code))
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		inst.duration.Record(ctx, elapsed, metric.WithAttributes(metricAttrs...))
+
+		return resp, err
+	}
+}
+
+var projectPathRe = regexp.MustCompile(`/api/v2/projects/(\d+)(?:/|$)`)
+
+// projectID extracts the project identifier from the request path.
+func projectID(path string) (int, bool) {
+	m := projectPathRe.FindStringSubmatch(path)
+	if m == nil {
+		return 0, false
+	}
+	id, err := strconv.Atoi(m[1])
+	return id, err == nil
+}
+
+// paginationOffset returns the pagination offset of the list request.
+func paginationOffset(r *http.Request, resp *crowdin.Response) (int, bool) {
+	if resp != nil && resp.Pagination.Limit > 0 {
+		return resp.Pagination.Offset, true
+	}
+	if v := r.URL.Query().Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		return offset, err == nil
+	}
+	if r.URL.Query().Has("limit") {
+		return 0, true
+	}
+	return 0, false
+}
+
+// errorCode returns the Crowdin error code of the API error.
+// For validation errors the code of the first error is returned.
+func errorCode(err error) (string, bool) {
+	var errResp *model.ErrorResponse
+	if errors.As(err, &errResp) {
+		if errResp.Err.Code == nil {
+			return strconv.Itoa(errResp.StatusCode()), true
+		}
+		return fmt.Sprint(errResp.Err.Code), true
+	}
+
+	if fields := model.FieldErrors(err); len(fields) > 0 {
+		return fields[0].Code, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,197 @@
+package otelcrowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+func setup(t *testing.T, opts ...crowdin.ClientOption) (*crowdin.Client, *http.ServeMux, *recorder) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	rec := newRecorder()
+	opts = append([]crowdin.ClientOption{
+		crowdin.WithBaseURL(server.URL),
+		crowdin.WithMiddleware(Middleware(WithTracerProvider(rec), WithMeterProvider(rec))),
+	}, opts...)
+	client, err := crowdin.NewClient("token", opts...)
+	require.NoError(t, err)
+
+	return client, mux, rec
+}
+
+func TestMiddleware_span(t *testing.T) {
+	client, mux, rec := setup(t)
+
+	mux.HandleFunc("/api/v2/projects/42/translations/builds", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": [], "pagination": {"offset": 50, "limit": 25}}`)
+	})
+
+	opts := &model.TranslationsBuildsListOptions{ListOptions: model.ListOptions{Offset: 50}}
+	_, _, err := client.Translations.ListProjectBuilds(context.Background(), 42, opts)
+	require.NoError(t, err)
+
+	spans := rec.ended()
+	require.Len(t, spans, 1)
+
+	span := spans[0]
+	assert.Equal(t, "TranslationsService.ListProjectBuilds", span.name)
+	assert.Equal(t, trace.SpanKindClient, span.kind)
+	assert.Equal(t, codes.Unset, span.status)
+
+	attrs := span.attrs
+	assert.Equal(t, "TranslationsService.ListProjectBuilds", attrs[OperationKey].AsString())
+	assert.Equal(t, "GET", attrs[HTTPMethodKey].AsString())
+	assert.Equal(t, int64(42), attrs[ProjectIDKey].AsInt64())
+	assert.Equal(t, int64(200), attrs[HTTPStatusCodeKey].AsInt64())
+	assert.Equal(t, int64(50), attrs[PaginationOffsetKey].AsInt64())
+	assert.NotContains(t, attrs, RetryCountKey)
+
+	durations := rec.measured("crowdin.client.request.duration")
+	require.Len(t, durations, 1)
+	status, _ := durations[0].attrs.Value(HTTPStatusCodeKey)
+	assert.Equal(t, int64(200), status.AsInt64())
+}
+
+func TestMiddleware_error(t *testing.T) {
+	client, mux, rec := setup(t)
+
+	mux.HandleFunc("/api/v2/projects/1/branches/2", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error": {"code": 404, "message": "Branch Not Found"}}`, http.StatusNotFound)
+	})
+
+	_, _, err := client.Branches.Get(context.Background(), 1, 2)
+	require.Error(t, err)
+
+	spans := rec.ended()
+	require.Len(t, spans, 1)
+
+	span := spans[0]
+	assert.Equal(t, "BranchesService.Get", span.name)
+	assert.Equal(t, codes.Error, span.status)
+	assert.Equal(t, []string{"exception"}, span.events)
+
+	attrs := span.attrs
+	assert.Equal(t, int64(404), attrs[HTTPStatusCodeKey].AsInt64())
+	assert.Equal(t, "404", attrs[ErrorCodeKey].AsString())
+	assert.NotContains(t, attrs, PaginationOffsetKey)
+}
+
+func TestMiddleware_retriesAndRateLimitWaits(t *testing.T) {
+	client, mux, rec := setup(t,
+		crowdin.WithRetryPolicy(crowdin.RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}),
+		crowdin.WithRateLimit(crowdin.RateLimit{RequestsPerSecond: 100}),
+	)
+
+	calls := 0
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+
+	spans := rec.ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, int64(2), spans[0].attrs[RetryCountKey].AsInt64())
+
+	retries := rec.measured("crowdin.client.retries")
+	require.Len(t, retries, 1)
+	assert.InDelta(t, 2, retries[0].value, 0)
+
+	waits := rec.measured("crowdin.client.rate_limit.wait")
+	require.Len(t, waits, 1)
+	assert.Positive(t, waits[0].value)
+}
+
+func TestMiddleware_propagatesSpanContext(t *testing.T) {
+	var gotSpan trace.SpanContext
+	inner := func(next crowdin.RoundTripFunc) crowdin.RoundTripFunc {
+		return func(r *http.Request, v any) (*crowdin.Response, error) {
+			gotSpan = trace.SpanContextFromContext(r.Context())
+			return next(r, v)
+		}
+	}
+	client, mux, rec := setup(t, crowdin.WithMiddleware(inner))
+
+	mux.HandleFunc("/api/v2/storages/1", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Storages.Get(context.Background(), 1)
+	require.NoError(t, err)
+
+	spans := rec.ended()
+	require.Len(t, spans, 1)
+	assert.True(t, gotSpan.IsValid())
+	assert.Equal(t, spans[0].sc.SpanID(), gotSpan.SpanID())
+}
+
+func TestProjectID(t *testing.T) {
+	tests := []struct {
+		path string
+		id   int
+		ok   bool
+	}{
+		{"/api/v2/projects/1", 1, true},
+		{"/api/v2/projects/12/files/3", 12, true},
+		{"/proxy/api/v2/projects/7", 7, true},
+		{"/api/v2/projects", 0, false},
+		{"/api/v2/projects/abc", 0, false},
+		{"/api/v2/storages/1", 0, false},
+	}
+
+	for _, tt := range tests {
+		id, ok := projectID(tt.path)
+		assert.Equal(t, tt.ok, ok, tt.path)
+		assert.Equal(t, tt.id, id, tt.path)
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	validationErr := &model.ValidationErrorResponse{Errors: []model.ValidationError{{}}}
+	validationErr.Errors[0].ErrorDetail.Key = "name"
+	validationErr.Errors[0].ErrorDetail.Errors = []model.Error{{Code: "isEmpty"}}
+
+	tests := []struct {
+		name string
+		err  error
+		code string
+		ok   bool
+	}{
+		{"error response", &model.ErrorResponse{Err: model.Error{Code: 403}}, "403", true},
+		{"validation error", validationErr, "isEmpty", true},
+		{"batch validation error", &model.BatchValidationErrorResponse{
+			Errors: []model.BatchValidationError{{Errors: validationErr.Errors}},
+		}, "isEmpty", true},
+		{"empty validation error", &model.ValidationErrorResponse{}, "", false},
+		{"other error", errors.New("boom"), "", false},
+	}
+
+	for _, tt := range tests {
+		code, ok := errorCode(tt.err)
+		assert.Equal(t, tt.ok, ok, tt.name)
+		assert.Equal(t, tt.code, code, tt.name)
+	}
+}
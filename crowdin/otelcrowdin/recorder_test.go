@@ -0,0 +1,183 @@
+package otelcrowdin
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// recorder is an in-memory TracerProvider and MeterProvider recording the
+// spans and measurements of the instrumentation, built on the API alone.
+type recorder struct {
+	tracenoop.TracerProvider
+	metricnoop.MeterProvider
+
+	mu           sync.Mutex
+	spans        []*span
+	measurements map[string][]measurement
+	nextID       uint64
+}
+
+func newRecorder() *recorder {
+	return &recorder{measurements: make(map[string][]measurement)}
+}
+
+// span is a recorded span.
+type span struct {
+	tracenoop.Span
+
+	rec    *recorder
+	name   string
+	kind   trace.SpanKind
+	sc     trace.SpanContext
+	attrs  map[attribute.Key]attribute.Value
+	events []string
+	status codes.Code
+	ended  bool
+}
+
+// measurement is a recorded counter increment or histogram value.
+type measurement struct {
+	value float64
+	attrs attribute.Set
+}
+
+// ended returns the ended spans.
+func (r *recorder) ended() []*span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var spans []*span
+	for _, s := range r.spans {
+		if s.ended {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+// measured returns the measurements of the instrument.
+func (r *recorder) measured(name string) []measurement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.measurements[name]
+}
+
+func (r *recorder) record(name string, value float64, attrs attribute.Set) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.measurements[name] = append(r.measurements[name], measurement{value: value, attrs: attrs})
+}
+
+func (r *recorder) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return tracer{rec: r}
+}
+
+func (r *recorder) Meter(string, ...metric.MeterOption) metric.Meter {
+	return meter{rec: r}
+}
+
+type tracer struct {
+	tracenoop.Tracer
+	rec *recorder
+}
+
+func (t tracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+
+	t.rec.mu.Lock()
+	defer t.rec.mu.Unlock()
+
+	t.rec.nextID++
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	binary.BigEndian.PutUint64(traceID[8:], t.rec.nextID)
+	binary.BigEndian.PutUint64(spanID[:], t.rec.nextID)
+
+	s := &span{
+		rec:   t.rec,
+		name:  name,
+		kind:  cfg.SpanKind(),
+		sc:    trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}),
+		attrs: make(map[attribute.Key]attribute.Value),
+	}
+	for _, kv := range cfg.Attributes() {
+		s.attrs[kv.Key] = kv.Value
+	}
+	t.rec.spans = append(t.rec.spans, s)
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+func (s *span) SpanContext() trace.SpanContext { return s.sc }
+
+func (s *span) IsRecording() bool { return true }
+
+func (s *span) SetAttributes(kv ...attribute.KeyValue) {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+	for _, a := range kv {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *span) RecordError(error, ...trace.EventOption) {
+	s.AddEvent("exception")
+}
+
+func (s *span) AddEvent(name string, _ ...trace.EventOption) {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+	s.events = append(s.events, name)
+}
+
+func (s *span) SetStatus(code codes.Code, _ string) {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+	s.status = code
+}
+
+func (s *span) End(...trace.SpanEndOption) {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+	s.ended = true
+}
+
+type meter struct {
+	metricnoop.Meter
+	rec *recorder
+}
+
+func (m meter) Float64Histogram(name string, _ ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	return histogram{rec: m.rec, name: name}, nil
+}
+
+func (m meter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return counter{rec: m.rec, name: name}, nil
+}
+
+type histogram struct {
+	metricnoop.Float64Histogram
+	rec  *recorder
+	name string
+}
+
+func (h histogram) Record(_ context.Context, value float64, opts ...metric.RecordOption) {
+	h.rec.record(h.name, value, metric.NewRecordConfig(opts).Attributes())
+}
+
+type counter struct {
+	metricnoop.Int64Counter
+	rec  *recorder
+	name string
+}
+
+func (c counter) Add(_ context.Context, incr int64, opts ...metric.AddOption) {
+	c.rec.record(c.name, float64(incr), metric.NewAddConfig(opts).Attributes())
+}
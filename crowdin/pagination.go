@@ -0,0 +1,176 @@
+package crowdin
+
+//go:generate go run ./internal/gen/listall -output list_all_gen.go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// MaxPageSize is the maximum number of items per page supported by the API.
+const MaxPageSize = 500
+
+// pageOptions is implemented by the list options embedding model.ListOptions.
+type pageOptions[O any] interface {
+	*O
+	ListOptionsProvider
+	PageOptions() *model.ListOptions
+}
+
+type prefetchKey struct{}
+
+// Prefetch makes the ListAll iterators fetch up to n pages concurrently
+// ahead of the consumer. The items are still returned in order and the
+// pages after a short page are discarded. The requests go through the
+// client rate limiter, so the prefetching cannot exceed its limits.
+// Other methods ignore this option.
+func Prefetch(n int) RequestOption {
+	return func(r *http.Request) error {
+		if n < 0 {
+			return errors.New("prefetch cannot be negative")
+		}
+		withContextValue(r, prefetchKey{}, n)
+		return nil
+	}
+}
+
+// prefetchCount returns the number of pages to prefetch
+// set on the request of the response.
+func prefetchCount(resp *Response) int {
+	if resp == nil || resp.Response == nil || resp.Request == nil {
+		return 0
+	}
+	n, _ := resp.Request.Context().Value(prefetchKey{}).(int)
+	return n
+}
+
+// listAll returns an iterator over the items of all the pages returned
+// by the list function. The pages are fetched lazily as the iteration
+// advances, starting at opts.Offset with opts.Limit items per page
+// (defaults to MaxPageSize). The opts are not modified.
+//
+// The iteration stops after the first error, when a short page is
+// returned or when the consumer stops the iteration.
+func listAll[O any, PO pageOptions[O], T any](
+	ctx context.Context,
+	opts PO,
+	list func(context.Context, PO) ([]T, *Response, error),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		template := PO(new(O))
+		if opts != nil {
+			*template = *opts
+		}
+
+		po := template.PageOptions()
+		if po.Limit > MaxPageSize {
+			yield(zero, fmt.Errorf("page size cannot be greater than %d", MaxPageSize))
+			return
+		}
+		if po.Limit <= 0 {
+			po.Limit = MaxPageSize
+		}
+		limit := po.Limit
+
+		// fetch lists the page at the offset. Each call uses
+		// its own copy of the options, so it can run concurrently.
+		fetch := func(ctx context.Context, offset int) ([]T, *Response, error) {
+			page := PO(new(O))
+			*page = *template
+			page.PageOptions().Offset = offset
+			return list(ctx, page)
+		}
+
+		offset := po.Offset
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, resp, err := fetch(ctx, offset)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) < limit {
+				return
+			}
+			offset += len(items)
+
+			if n := prefetchCount(resp); n > 0 {
+				prefetchPages(ctx, offset, limit, n, fetch, yield)
+				return
+			}
+		}
+	}
+}
+
+// pageResult is the result of a prefetched page.
+type pageResult[T any] struct {
+	items []T
+	err   error
+}
+
+// prefetchPages yields the items of the pages starting at the offset,
+// keeping up to n pages in flight ahead of the consumer. It stops after
+// the first error or short page, and cancels the pending requests.
+func prefetchPages[T any](
+	ctx context.Context,
+	offset, limit, n int,
+	fetch func(context.Context, int) ([]T, *Response, error),
+	yield func(T, error) bool,
+) {
+	var zero T
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var pending []chan pageResult[T]
+	start := func() {
+		ch := make(chan pageResult[T], 1)
+		go func(offset int) {
+			items, _, err := fetch(ctx, offset)
+			ch <- pageResult[T]{items: items, err: err}
+		}(offset)
+		pending = append(pending, ch)
+		offset += limit
+	}
+
+	for range n {
+		start()
+	}
+
+	for len(pending) > 0 {
+		res := <-pending[0]
+		pending = pending[1:]
+
+		if res.err != nil {
+			yield(zero, res.err)
+			return
+		}
+		for _, item := range res.items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+
+		if len(res.items) < limit {
+			return
+		}
+		start()
+	}
+}
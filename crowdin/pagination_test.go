@@ -0,0 +1,378 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// inFlight tracks the maximum number of concurrent requests.
+type inFlight struct {
+	mu      sync.Mutex
+	current int
+	max     int
+}
+
+func (f *inFlight) start() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.current++
+	f.max = max(f.max, f.current)
+}
+
+func (f *inFlight) done() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.current--
+}
+
+func (f *inFlight) maximum() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.max
+}
+
+// handlePages serves the total number of items in pages. It returns
+// a function reporting the query strings of the requests.
+func handlePages(t *testing.T, mux *http.ServeMux, path string, total int) func() []string {
+	t.Helper()
+
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		mu.Lock()
+		requests = append(requests, r.URL.RawQuery)
+		mu.Unlock()
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var items []string
+		for i := offset; i < offset+limit && i < total; i++ {
+			items = append(items, fmt.Sprintf(`{"data": {"id": %d}}`, i+1))
+		}
+		fmt.Fprintf(w, `{"data": [%s], "pagination": {"offset": %d, "limit": %d}}`, strings.Join(items, ","), offset, limit)
+	})
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), requests...)
+	}
+}
+
+func TestListAll(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	requests := handlePages(t, mux, "/api/v2/projects", 5)
+
+	opts := &model.ProjectsListOptions{HasManagerAccess: ToPtr(1), ListOptions: model.ListOptions{Limit: 2}}
+
+	var ids []int
+	for project, err := range client.Projects.ListAll(context.Background(), opts) {
+		require.NoError(t, err)
+		ids = append(ids, project.ID)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids)
+	assert.Equal(t, []string{
+		"hasManagerAccess=1&limit=2",
+		"hasManagerAccess=1&limit=2&offset=2",
+		"hasManagerAccess=1&limit=2&offset=4",
+	}, requests())
+	assert.Equal(t, model.ListOptions{Limit: 2}, opts.ListOptions, "opts are not modified")
+}
+
+func TestListAll_defaultPageSize(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	requests := handlePages(t, mux, "/api/v2/projects/1/strings", 3)
+
+	var count int
+	for _, err := range client.SourceStrings.ListAll(context.Background(), 1, nil) {
+		require.NoError(t, err)
+		count++
+	}
+
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []string{"limit=500"}, requests())
+}
+
+func TestListAll_offset(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	requests := handlePages(t, mux, "/api/v2/storages", 4)
+
+	var ids []int
+	for storage, err := range client.Storages.ListAll(context.Background(), &model.ListOptions{Offset: 1, Limit: 2}) {
+		require.NoError(t, err)
+		ids = append(ids, storage.ID)
+	}
+
+	assert.Equal(t, []int{2, 3, 4}, ids)
+	assert.Equal(t, []string{"limit=2&offset=1", "limit=2&offset=3"}, requests())
+}
+
+func TestListAll_earlyTermination(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	requests := handlePages(t, mux, "/api/v2/storages", 10)
+
+	var ids []int
+	for storage, err := range client.Storages.ListAll(context.Background(), &model.ListOptions{Limit: 2}) {
+		require.NoError(t, err)
+		ids = append(ids, storage.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Len(t, requests(), 2)
+}
+
+func TestListAll_error(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "" {
+			http.Error(w, `{"error": {"code": 500, "message": "Internal Server Error"}}`, http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"data": [{"data": {"id": 1}}], "pagination": {"offset": 0, "limit": 1}}`)
+	})
+
+	var (
+		ids  []int
+		errs []error
+	)
+	for storage, err := range client.Storages.ListAll(context.Background(), &model.ListOptions{Limit: 1}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, storage.ID)
+	}
+
+	assert.Equal(t, []int{1}, ids)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrServerError)
+}
+
+func TestListAll_contextCanceled(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	requests := handlePages(t, mux, "/api/v2/storages", 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		count int
+		err   error
+	)
+	for _, err = range client.Storages.ListAll(ctx, &model.ListOptions{Limit: 2}) {
+		if err != nil {
+			break
+		}
+		count++
+		cancel()
+	}
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, count)
+	assert.Len(t, requests(), 1)
+}
+
+func TestListAll_pageSizeTooLarge(t *testing.T) {
+	client, _, teardown := setupClient()
+	defer teardown()
+
+	for _, err := range client.Storages.ListAll(context.Background(), &model.ListOptions{Limit: 501}) {
+		require.EqualError(t, err, "page size cannot be greater than 500")
+	}
+}
+
+// TestListAll_everyListMethod checks that every paginated
+// method of the services has an iterator.
+func TestListAll_everyListMethod(t *testing.T) {
+	client, err := NewClient("token")
+	require.NoError(t, err)
+
+	pageOptions := reflect.TypeOf((*interface{ PageOptions() *model.ListOptions })(nil)).Elem()
+	sliceResults := 0
+
+	v := reflect.ValueOf(client).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		service := v.Field(i)
+		if service.Kind() != reflect.Pointer || !strings.HasSuffix(service.Type().Elem().Name(), "Service") {
+			continue
+		}
+
+		for j := 0; j < service.NumMethod(); j++ {
+			m := service.Type().Method(j)
+			if m.Type.NumOut() != 3 || m.Type.Out(0).Kind() != reflect.Slice {
+				continue
+			}
+
+			paged := false
+			for k := 1; k < m.Type.NumIn(); k++ {
+				if m.Type.In(k).Implements(pageOptions) {
+					paged = true
+				}
+			}
+			if !paged {
+				continue
+			}
+			sliceResults++
+
+			verb := strings.IndexFunc(m.Name[1:], func(r rune) bool { return r >= 'A' && r <= 'Z' }) + 1
+			if verb == 0 {
+				verb = len(m.Name)
+			}
+			name := m.Name[:verb] + "All" + m.Name[verb:]
+			_, ok := service.Type().MethodByName(name)
+			assert.True(t, ok, "%s.%s has no %s iterator", service.Type().Elem().Name(), m.Name, name)
+		}
+	}
+	assert.Positive(t, sliceResults)
+}
+
+func TestListAll_prefetch(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	var requests inFlight
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		requests.start()
+		defer requests.done()
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		// Earlier pages are slower to check that the order is preserved.
+		time.Sleep(time.Duration(20-offset) * time.Millisecond)
+
+		var items []string
+		for i := offset; i < offset+2 && i < 15; i++ {
+			items = append(items, fmt.Sprintf(`{"data": {"id": %d}}`, i+1))
+		}
+		fmt.Fprintf(w, `{"data": [%s]}`, strings.Join(items, ","))
+	})
+
+	var ids []int
+	for storage, err := range client.Storages.ListAll(context.Background(), &model.ListOptions{Limit: 2}, Prefetch(4)) {
+		require.NoError(t, err)
+		ids = append(ids, storage.ID)
+	}
+
+	var want []int
+	for i := 1; i <= 15; i++ {
+		want = append(want, i)
+	}
+	assert.Equal(t, want, ids)
+	assert.Greater(t, requests.maximum(), 1)
+	assert.LessOrEqual(t, requests.maximum(), 4)
+}
+
+func TestListAll_prefetchStopsOnShortPage(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	requests := handlePages(t, mux, "/api/v2/storages", 3)
+
+	var count int
+	for _, err := range client.Storages.ListAll(context.Background(), &model.ListOptions{Limit: 2}, Prefetch(3)) {
+		require.NoError(t, err)
+		count++
+	}
+
+	assert.Equal(t, 3, count)
+	assert.Contains(t, requests(), "limit=2")
+	assert.Contains(t, requests(), "limit=2&offset=2")
+}
+
+func TestListAll_prefetchError(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if offset >= 4 {
+			http.Error(w, `{"error": {"code": 403, "message": "Forbidden"}}`, http.StatusForbidden)
+			return
+		}
+		fmt.Fprintf(w, `{"data": [{"data": {"id": %d}}, {"data": {"id": %d}}]}`, offset+1, offset+2)
+	})
+
+	var (
+		ids  []int
+		errs []error
+	)
+	for storage, err := range client.Storages.ListAll(context.Background(), &model.ListOptions{Limit: 2}, Prefetch(2)) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, storage.ID)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, ids)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrForbidden)
+}
+
+func TestListAll_prefetchRespectsRateLimit(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+	require.NoError(t, WithRateLimit(RateLimit{MaxConcurrent: 2})(client))
+
+	var requests inFlight
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		requests.start()
+		defer requests.done()
+		time.Sleep(5 * time.Millisecond)
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if offset >= 20 {
+			fmt.Fprint(w, `{"data": []}`)
+			return
+		}
+		fmt.Fprintf(w, `{"data": [{"data": {"id": %d}}]}`, offset+1)
+	})
+
+	var count int
+	for _, err := range client.Storages.ListAll(context.Background(), &model.ListOptions{Limit: 1}, Prefetch(8)) {
+		require.NoError(t, err)
+		count++
+	}
+
+	assert.Equal(t, 20, count)
+	assert.LessOrEqual(t, requests.maximum(), 2)
+}
+
+func TestPrefetch_negative(t *testing.T) {
+	client, _, teardown := setupClient()
+	defer teardown()
+
+	for _, err := range client.Storages.ListAll(context.Background(), nil, Prefetch(-1)) {
+		require.EqualError(t, err, "prefetch cannot be negative")
+	}
+}
@@ -19,9 +19,10 @@ type ProjectsService struct {
 // List returns a list of projects.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.getMany
-func (s *ProjectsService) List(ctx context.Context, opts *model.ProjectsListOptions) ([]*model.Project, *Response, error) {
+func (s *ProjectsService) List(ctx context.Context, opts *model.ProjectsListOptions, reqOpts ...RequestOption) ([]*model.Project, *Response, error) {
+	ctx = withOperation(ctx, "ProjectsService.List")
 	res := new(model.ProjectsListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/projects", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/projects", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -37,9 +38,10 @@ func (s *ProjectsService) List(ctx context.Context, opts *model.ProjectsListOpti
 // Get returns a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.get
-func (s *ProjectsService) Get(ctx context.Context, id int) (*model.Project, *Response, error) {
+func (s *ProjectsService) Get(ctx context.Context, id int, reqOpts ...RequestOption) (*model.Project, *Response, error) {
+	ctx = withOperation(ctx, "ProjectsService.Get")
 	res := new(model.ProjectsGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d", id), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d", id), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -47,9 +49,10 @@ func (s *ProjectsService) Get(ctx context.Context, id int) (*model.Project, *Res
 // Add creates a new project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.post
-func (s *ProjectsService) Add(ctx context.Context, req *model.ProjectsAddRequest) (*model.Project, *Response, error) {
+func (s *ProjectsService) Add(ctx context.Context, req *model.ProjectsAddRequest, reqOpts ...RequestOption) (*model.Project, *Response, error) {
+	ctx = withOperation(ctx, "ProjectsService.Add")
 	res := new(model.ProjectsGetResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/projects", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/projects", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -62,9 +65,10 @@ func (s *ProjectsService) Add(ctx context.Context, req *model.ProjectsAddRequest
 //   - value: The value to be used within the operations. The value must be one of string, integer, boolean and object
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.patch
-func (s *ProjectsService) Edit(ctx context.Context, id int, req []*model.UpdateRequest) (*model.Project, *Response, error) {
+func (s *ProjectsService) Edit(ctx context.Context, id int, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.Project, *Response, error) {
+	ctx = withOperation(ctx, "ProjectsService.Edit")
 	res := new(model.ProjectsGetResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d", id), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d", id), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -72,20 +76,22 @@ func (s *ProjectsService) Edit(ctx context.Context, id int, req []*model.UpdateR
 // Delete deletes a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.delete
-func (s *ProjectsService) Delete(ctx context.Context, id int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d", id), nil)
+func (s *ProjectsService) Delete(ctx context.Context, id int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ProjectsService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d", id), nil, reqOpts...)
 }
 
 // DownloadFileFormatSettingsCustomSegmentation returns a download link for custom segmentations
 // by project and file format settings identifiers.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.custom-segmentations.get
-func (s *ProjectsService) DownloadFileFormatSettingsCustomSegmentation(ctx context.Context, projectID, settingsID int) (
+func (s *ProjectsService) DownloadFileFormatSettingsCustomSegmentation(ctx context.Context, projectID, settingsID int, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.DownloadFileFormatSettingsCustomSegmentation")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d/custom-segmentations", projectID, settingsID)
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -94,20 +100,22 @@ func (s *ProjectsService) DownloadFileFormatSettingsCustomSegmentation(ctx conte
 // and file format settings identifiers.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.custom-segmentations.delete
-func (s *ProjectsService) ResetFileFormatSettingsCustomSegmentation(ctx context.Context, projectID, settingsID int) (*Response, error) {
+func (s *ProjectsService) ResetFileFormatSettingsCustomSegmentation(ctx context.Context, projectID, settingsID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ProjectsService.ResetFileFormatSettingsCustomSegmentation")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d/custom-segmentations", projectID, settingsID)
-	return s.client.Delete(ctx, path, nil)
+	return s.client.Delete(ctx, path, nil, reqOpts...)
 }
 
 // ListFileFormatSettings returns a list of project file format settings by project identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.getMany
-func (s *ProjectsService) ListFileFormatSettings(ctx context.Context, projectID int) (
+func (s *ProjectsService) ListFileFormatSettings(ctx context.Context, projectID int, reqOpts ...RequestOption) (
 	[]*model.ProjectsFileFormatSettings, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.ListFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings", projectID)
 	res := new(model.ProjectsFileFormatSettingsListResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -123,12 +131,13 @@ func (s *ProjectsService) ListFileFormatSettings(ctx context.Context, projectID
 // GetFileFormatSettings returns a project file format settings by project and file format settings identifiers.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.get
-func (s *ProjectsService) GetFileFormatSettings(ctx context.Context, projectID, settingsID int) (
+func (s *ProjectsService) GetFileFormatSettings(ctx context.Context, projectID, settingsID int, reqOpts ...RequestOption) (
 	*model.ProjectsFileFormatSettings, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.GetFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d", projectID, settingsID)
 	res := new(model.ProjectsFileFormatSettingsResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -136,12 +145,13 @@ func (s *ProjectsService) GetFileFormatSettings(ctx context.Context, projectID,
 // AddFileFormatSettings adds a new project file format settings by its project identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.post
-func (s *ProjectsService) AddFileFormatSettings(ctx context.Context, projectID int, req *model.ProjectsAddFileFormatSettingsRequest) (
+func (s *ProjectsService) AddFileFormatSettings(ctx context.Context, projectID int, req *model.ProjectsAddFileFormatSettingsRequest, reqOpts ...RequestOption) (
 	*model.ProjectsFileFormatSettings, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.AddFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings", projectID)
 	res := new(model.ProjectsFileFormatSettingsResponse)
-	resp, err := s.client.Post(ctx, path, req, res)
+	resp, err := s.client.Post(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -154,12 +164,13 @@ func (s *ProjectsService) AddFileFormatSettings(ctx context.Context, projectID i
 //   - value: The value to be used within the operations. The value must be one of string or array of strings.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.patch
-func (s *ProjectsService) EditFileFormatSettings(ctx context.Context, projectID, settingsID int, req []*model.UpdateRequest) (
+func (s *ProjectsService) EditFileFormatSettings(ctx context.Context, projectID, settingsID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.ProjectsFileFormatSettings, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.EditFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d", projectID, settingsID)
 	res := new(model.ProjectsFileFormatSettingsResponse)
-	resp, err := s.client.Patch(ctx, path, req, res)
+	resp, err := s.client.Patch(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -167,20 +178,22 @@ func (s *ProjectsService) EditFileFormatSettings(ctx context.Context, projectID,
 // DeleteFileFormatSettings deletes a project file format settings by project and file format settings identifiers.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.file-format-settings.delete
-func (s *ProjectsService) DeleteFileFormatSettings(ctx context.Context, projectID, settingsID int) (*Response, error) {
+func (s *ProjectsService) DeleteFileFormatSettings(ctx context.Context, projectID, settingsID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ProjectsService.DeleteFileFormatSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/file-format-settings/%d", projectID, settingsID)
-	return s.client.Delete(ctx, path, nil)
+	return s.client.Delete(ctx, path, nil, reqOpts...)
 }
 
 // ListStringsExporterSettings returns a list of project strings exporter settings by project identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings-exporter-settings.getMany
-func (s *ProjectsService) ListStringsExporterSettings(ctx context.Context, projectID int) (
+func (s *ProjectsService) ListStringsExporterSettings(ctx context.Context, projectID int, reqOpts ...RequestOption) (
 	[]*model.ProjectsStringsExporterSettings, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.ListStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings", projectID)
 	res := new(model.ProjectsStringsExporterSettingsListResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -197,12 +210,13 @@ func (s *ProjectsService) ListStringsExporterSettings(ctx context.Context, proje
 // and strings exporter settings identifiers.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings-exporter-settings.get
-func (s *ProjectsService) GetStringsExporterSettings(ctx context.Context, projectID, settingsID int) (
+func (s *ProjectsService) GetStringsExporterSettings(ctx context.Context, projectID, settingsID int, reqOpts ...RequestOption) (
 	*model.ProjectsStringsExporterSettings, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.GetStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings/%d", projectID, settingsID)
 	res := new(model.ProjectsStringsExporterSettingsResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -219,11 +233,13 @@ func (s *ProjectsService) AddStringsExporterSettings(
 	ctx context.Context,
 	projectID int,
 	req *model.ProjectsStringsExporterSettingsRequest,
+	reqOpts ...RequestOption,
 ) (*model.ProjectsStringsExporterSettings, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.AddStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings", projectID)
 	res := new(model.ProjectsStringsExporterSettingsResponse)
-	resp, err := s.client.Post(ctx, path, req, res)
+	resp, err := s.client.Post(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -241,11 +257,13 @@ func (s *ProjectsService) EditStringsExporterSettings(
 	ctx context.Context,
 	projectID, settingsID int,
 	req *model.ProjectsStringsExporterSettingsRequest,
+	reqOpts ...RequestOption,
 ) (*model.ProjectsStringsExporterSettings, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ProjectsService.EditStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings/%d", projectID, settingsID)
 	res := new(model.ProjectsStringsExporterSettingsResponse)
-	resp, err := s.client.Patch(ctx, path, req, res)
+	resp, err := s.client.Patch(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -254,7 +272,8 @@ func (s *ProjectsService) EditStringsExporterSettings(
 // and strings exporter settings identifiers.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings-exporter-settings.delete
-func (s *ProjectsService) DeleteStringsExporterSettings(ctx context.Context, projectID, settingsID int) (*Response, error) {
+func (s *ProjectsService) DeleteStringsExporterSettings(ctx context.Context, projectID, settingsID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ProjectsService.DeleteStringsExporterSettings")
 	path := fmt.Sprintf("/api/v2/projects/%d/strings-exporter-settings/%d", projectID, settingsID)
-	return s.client.Delete(ctx, path, nil)
+	return s.client.Delete(ctx, path, nil, reqOpts...)
 }
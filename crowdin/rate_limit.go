@@ -0,0 +1,125 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimit configures client-side throttling of requests.
+// The limits are shared by all services of the same client.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained number of requests
+	// per second. Zero means no rate limit.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests that can be sent at
+	// once before throttling kicks in (default 1).
+	Burst int
+	// MaxConcurrent is the maximum number of in-flight requests.
+	// Zero means no limit.
+	MaxConcurrent int
+}
+
+// WithRateLimit enables client-side throttling of requests. Requests that
+// exceed the limits wait until they are allowed to proceed or until
+// the request context is done.
+func WithRateLimit(l RateLimit) ClientOption {
+	return func(c *Client) error {
+		if l.RequestsPerSecond < 0 || l.Burst < 0 || l.MaxConcurrent < 0 {
+			return errors.New("rate limit values cannot be negative")
+		}
+		if l.RequestsPerSecond == 0 && l.MaxConcurrent == 0 {
+			return errors.New("rate limit requires requests per second or max concurrent requests")
+		}
+		c.limiter = newRateLimiter(l)
+		return nil
+	}
+}
+
+// rateLimiter is a token bucket combined with a semaphore
+// limiting the number of in-flight requests.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	sem chan struct{}
+}
+
+func newRateLimiter(l RateLimit) *rateLimiter {
+	rl := &rateLimiter{rate: l.RequestsPerSecond}
+	if rl.rate > 0 {
+		rl.burst = math.Max(float64(l.Burst), 1)
+		rl.tokens = rl.burst
+		rl.last = time.Now()
+	}
+	if l.MaxConcurrent > 0 {
+		rl.sem = make(chan struct{}, l.MaxConcurrent)
+	}
+	return rl
+}
+
+// wait blocks until the request is allowed to proceed. It returns a release
+// function that must be called once the request is completed, and the time
+// spent waiting.
+func (rl *rateLimiter) wait(ctx context.Context) (release func(), waited time.Duration, err error) {
+	start := time.Now()
+
+	if rl.sem != nil {
+		select {
+		case rl.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, time.Since(start), ctx.Err()
+		}
+	}
+	release = func() {
+		if rl.sem != nil {
+			<-rl.sem
+		}
+	}
+
+	if rl.rate > 0 {
+		if delay := rl.reserve(); delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				rl.cancel()
+				release()
+				return nil, time.Since(start), ctx.Err()
+			}
+		}
+	}
+
+	return release, time.Since(start), nil
+}
+
+// reserve takes a token from the bucket and returns how long
+// the caller has to wait before the token becomes available.
+func (rl *rateLimiter) reserve() time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	rl.tokens = math.Min(rl.burst, rl.tokens+now.Sub(rl.last).Seconds()*rl.rate)
+	rl.last = now
+	rl.tokens--
+
+	if rl.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-rl.tokens / rl.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token to the bucket.
+func (rl *rateLimiter) cancel() {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.tokens = math.Min(rl.burst, rl.tokens+1)
+}
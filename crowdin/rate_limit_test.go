@@ -0,0 +1,137 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit_maxConcurrent(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+	require.NoError(t, WithRateLimit(RateLimit{MaxConcurrent: 2})(client))
+
+	var inFlight, maxInFlight int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, `{}`)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Get(context.Background(), "/get", nil, nil)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 2, atomic.LoadInt32(&maxInFlight))
+}
+
+func TestRateLimit_sharedAcrossServices(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+	require.NoError(t, WithRateLimit(RateLimit{RequestsPerSecond: 20, Burst: 1})(client))
+
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": []}`)
+	})
+	mux.HandleFunc("/api/v2/languages", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": []}`)
+	})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, _, err := client.Storages.List(context.Background(), nil)
+		require.NoError(t, err)
+		_, _, err = client.Languages.List(context.Background(), nil)
+		require.NoError(t, err)
+	}
+
+	// 6 requests with burst 1 at 20 rps take at least 5 * 50ms.
+	assert.GreaterOrEqual(t, time.Since(start), 240*time.Millisecond)
+}
+
+func TestRateLimit_contextCancelled(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+	require.NoError(t, WithRateLimit(RateLimit{RequestsPerSecond: 0.001})(client))
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := client.Get(context.Background(), "/get", nil, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Get(ctx, "/get", nil, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestRateLimiter_cancelReturnsToken(t *testing.T) {
+	rl := newRateLimiter(RateLimit{RequestsPerSecond: 0.001})
+
+	release, _, err := rl.wait(context.Background())
+	require.NoError(t, err)
+	release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = rl.wait(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	assert.InDelta(t, 0, rl.tokens, 0.01)
+}
+
+func TestRateLimiter_semaphoreReleased(t *testing.T) {
+	rl := newRateLimiter(RateLimit{MaxConcurrent: 1})
+
+	release, _, err := rl.wait(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = rl.wait(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	release()
+	assert.Empty(t, rl.sem)
+}
+
+func TestWithRateLimit(t *testing.T) {
+	c, err := NewClient("token", WithRateLimit(RateLimit{RequestsPerSecond: 10, MaxConcurrent: 5}))
+	require.NoError(t, err)
+	assert.NotNil(t, c.limiter)
+	assert.Equal(t, 5, cap(c.limiter.sem))
+	assert.InDelta(t, 1, c.limiter.burst, 0)
+
+	_, err = NewClient("token", WithRateLimit(RateLimit{RequestsPerSecond: -1}))
+	require.EqualError(t, err, "rate limit values cannot be negative")
+
+	_, err = NewClient("token", WithRateLimit(RateLimit{}))
+	require.EqualError(t, err, "rate limit requires requests per second or max concurrent requests")
+}
@@ -23,11 +23,12 @@ type ReportsService struct {
 //	For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.reports.archives.getMany
-func (s *ReportsService) ListArchives(ctx context.Context, userID int, opts *model.ReportArchivesListOptions) (
+func (s *ReportsService) ListArchives(ctx context.Context, userID int, opts *model.ReportArchivesListOptions, reqOpts ...RequestOption) (
 	[]*model.ReportArchive, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.ListArchives")
 	res := new(model.ReportArchiveListResponse)
-	resp, err := s.client.Get(ctx, s.getArchivePath("archives", userID), opts, res)
+	resp, err := s.client.Get(ctx, s.getArchivePath("archives", userID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -45,10 +46,11 @@ func (s *ReportsService) ListArchives(ctx context.Context, userID int, opts *mod
 //	For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.reports.archives.get
-func (s *ReportsService) GetArchive(ctx context.Context, userID, archiveID int) (*model.ReportArchive, *Response, error) {
+func (s *ReportsService) GetArchive(ctx context.Context, userID, archiveID int, reqOpts ...RequestOption) (*model.ReportArchive, *Response, error) {
+	ctx = withOperation(ctx, "ReportsService.GetArchive")
 	path := s.getArchivePath(fmt.Sprintf("archives/%d", archiveID), userID)
 	res := new(model.ReportArchiveResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -58,8 +60,9 @@ func (s *ReportsService) GetArchive(ctx context.Context, userID, archiveID int)
 //	For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.reports.archives.delete
-func (s *ReportsService) DeleteArchive(ctx context.Context, userID, archiveID int) (*Response, error) {
-	return s.client.Delete(ctx, s.getArchivePath(fmt.Sprintf("archives/%d", archiveID), userID), nil)
+func (s *ReportsService) DeleteArchive(ctx context.Context, userID, archiveID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ReportsService.DeleteArchive")
+	return s.client.Delete(ctx, s.getArchivePath(fmt.Sprintf("archives/%d", archiveID), userID), nil, reqOpts...)
 }
 
 // ExportArchive exports a report archive in the specified file format. If no format is provided,
@@ -68,16 +71,17 @@ func (s *ReportsService) DeleteArchive(ctx context.Context, userID, archiveID in
 //	For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.reports.archives.exports.post
-func (s *ReportsService) ExportArchive(ctx context.Context, userID, archiveID int, req *model.ExportReportArchiveRequest) (
+func (s *ReportsService) ExportArchive(ctx context.Context, userID, archiveID int, req *model.ExportReportArchiveRequest, reqOpts ...RequestOption) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.ExportArchive")
 	if req == nil || req.Format == "" {
 		req = &model.ExportReportArchiveRequest{Format: model.ReportFormatXLSX}
 	}
 
 	path := s.getArchivePath(fmt.Sprintf("archives/%d/exports", archiveID), userID)
 	res := new(model.ReportStatusResponse)
-	resp, err := s.client.Post(ctx, path, req, res)
+	resp, err := s.client.Post(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -87,27 +91,39 @@ func (s *ReportsService) ExportArchive(ctx context.Context, userID, archiveID in
 //	For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.reports.archives.exports.get
-func (s *ReportsService) CheckArchiveExportStatus(ctx context.Context, userID, archiveID int, exportID string) (
+func (s *ReportsService) CheckArchiveExportStatus(ctx context.Context, userID, archiveID int, exportID string, reqOpts ...RequestOption) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.CheckArchiveExportStatus")
 	path := s.getArchivePath(fmt.Sprintf("archives/%d/exports/%s", archiveID, exportID), userID)
 	res := new(model.ReportStatusResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// ArchiveExportOperation returns an operation polling the status of the report archive export
+// with CheckArchiveExportStatus.
+func (s *ReportsService) ArchiveExportOperation(userID, archiveID int, exportID string, reqOpts ...RequestOption) *Operation[model.ReportStatus] {
+	return newOperation(func(ctx context.Context) (*model.ReportStatus, *Response, error) {
+		return s.CheckArchiveExportStatus(ctx, userID, archiveID, exportID, reqOpts...)
+	}, func(v *model.ReportStatus) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // DownloadArchive returns a download link for the report archive.
 //
 //	For the Enterprise client, set the userID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.users.reports.archives.exports.download.get
-func (s *ReportsService) DownloadArchive(ctx context.Context, userID, archiveID int, exportID string) (
+func (s *ReportsService) DownloadArchive(ctx context.Context, userID, archiveID int, exportID string, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.DownloadArchive")
 	path := s.getArchivePath(fmt.Sprintf("archives/%d/exports/%s/download", archiveID, exportID), userID)
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -115,11 +131,12 @@ func (s *ReportsService) DownloadArchive(ctx context.Context, userID, archiveID
 // Generate generates a new report.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.post
-func (s *ReportsService) Generate(ctx context.Context, projectID int, req *model.ReportGenerateRequest) (
+func (s *ReportsService) Generate(ctx context.Context, projectID int, req *model.ReportGenerateRequest, reqOpts ...RequestOption) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.Generate")
 	res := new(model.ReportStatusResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/reports", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/reports", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -127,23 +144,35 @@ func (s *ReportsService) Generate(ctx context.Context, projectID int, req *model
 // CheckStatus returns the status of the report generation.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.get
-func (s *ReportsService) CheckStatus(ctx context.Context, projectID int, reportID string) (
+func (s *ReportsService) CheckStatus(ctx context.Context, projectID int, reportID string, reqOpts ...RequestOption) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.CheckStatus")
 	res := new(model.ReportStatusResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/reports/%s", projectID, reportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/reports/%s", projectID, reportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// Operation returns an operation polling the status of the report generation
+// with CheckStatus.
+func (s *ReportsService) Operation(projectID int, reportID string, reqOpts ...RequestOption) *Operation[model.ReportStatus] {
+	return newOperation(func(ctx context.Context) (*model.ReportStatus, *Response, error) {
+		return s.CheckStatus(ctx, projectID, reportID, reqOpts...)
+	}, func(v *model.ReportStatus) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // Download returns a download link for the report.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.download.download
-func (s *ReportsService) Download(ctx context.Context, projectID int, reportID string) (
+func (s *ReportsService) Download(ctx context.Context, projectID int, reportID string, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.Download")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/reports/%s/download", projectID, reportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/reports/%s/download", projectID, reportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -153,11 +182,12 @@ func (s *ReportsService) Download(ctx context.Context, projectID int, reportID s
 //	For the Enterprise client, set the projectID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.settings-templates.getMany
-func (s *ReportsService) ListSettingsTemplates(ctx context.Context, projectID int, opts *model.ReportSettingsTemplatesListOptions) (
+func (s *ReportsService) ListSettingsTemplates(ctx context.Context, projectID int, opts *model.ReportSettingsTemplatesListOptions, reqOpts ...RequestOption) (
 	[]*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.ListSettingsTemplates")
 	res := new(model.ReportSettingsTemplateListResponse)
-	resp, err := s.client.Get(ctx, s.getSettingsTemplatePath(projectID, 0), opts, res)
+	resp, err := s.client.Get(ctx, s.getSettingsTemplatePath(projectID, 0), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -175,11 +205,12 @@ func (s *ReportsService) ListSettingsTemplates(ctx context.Context, projectID in
 //	For the Enterprise client, set the projectID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.settings-templates.get
-func (s *ReportsService) GetSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int) (
+func (s *ReportsService) GetSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int, reqOpts ...RequestOption) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.GetSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
-	resp, err := s.client.Get(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID), nil, res)
+	resp, err := s.client.Get(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -189,11 +220,12 @@ func (s *ReportsService) GetSettingsTemplate(ctx context.Context, projectID, set
 //	For the Enterprise client, set the projectID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.settings-templates.post
-func (s *ReportsService) AddSettingsTemplate(ctx context.Context, projectID int, req *model.ReportSettingsTemplateAddRequest) (
+func (s *ReportsService) AddSettingsTemplate(ctx context.Context, projectID int, req *model.ReportSettingsTemplateAddRequest, reqOpts ...RequestOption) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.AddSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
-	resp, err := s.client.Post(ctx, s.getSettingsTemplatePath(projectID, 0), req, res)
+	resp, err := s.client.Post(ctx, s.getSettingsTemplatePath(projectID, 0), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -209,11 +241,12 @@ func (s *ReportsService) AddSettingsTemplate(ctx context.Context, projectID int,
 //   - Value (any): new value to set.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.settings-templates.patch
-func (s *ReportsService) EditSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int, req []*model.UpdateRequest) (
+func (s *ReportsService) EditSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.EditSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
-	resp, err := s.client.Patch(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID), req, res)
+	resp, err := s.client.Patch(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -223,18 +256,20 @@ func (s *ReportsService) EditSettingsTemplate(ctx context.Context, projectID, se
 //	For the Enterprise client, set the projectID to 0.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.reports.settings-templates.delete
-func (s *ReportsService) DeleteSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int) (*Response, error) {
-	return s.client.Delete(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID), nil)
+func (s *ReportsService) DeleteSettingsTemplate(ctx context.Context, projectID, settingsTemplateID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ReportsService.DeleteSettingsTemplate")
+	return s.client.Delete(ctx, s.getSettingsTemplatePath(projectID, settingsTemplateID), nil, reqOpts...)
 }
 
 // GenerateGroupReport generates a new group report.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.reports.post
-func (s *ReportsService) GenerateGroupReport(ctx context.Context, groupID int, req *model.GroupReportGenerateRequest) (
+func (s *ReportsService) GenerateGroupReport(ctx context.Context, groupID int, req *model.GroupReportGenerateRequest, reqOpts ...RequestOption) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.GenerateGroupReport")
 	res := new(model.ReportStatusResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/groups/%d/reports", groupID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/groups/%d/reports", groupID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -242,23 +277,35 @@ func (s *ReportsService) GenerateGroupReport(ctx context.Context, groupID int, r
 // CheckGroupReportStatus returns the status of the group report generation.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.reports.get
-func (s *ReportsService) CheckGroupReportStatus(ctx context.Context, groupID int, reportID string) (
+func (s *ReportsService) CheckGroupReportStatus(ctx context.Context, groupID int, reportID string, reqOpts ...RequestOption) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.CheckGroupReportStatus")
 	res := new(model.ReportStatusResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/reports/%s", groupID, reportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/reports/%s", groupID, reportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// GroupReportOperation returns an operation polling the status of the group report generation
+// with CheckGroupReportStatus.
+func (s *ReportsService) GroupReportOperation(groupID int, reportID string, reqOpts ...RequestOption) *Operation[model.ReportStatus] {
+	return newOperation(func(ctx context.Context) (*model.ReportStatus, *Response, error) {
+		return s.CheckGroupReportStatus(ctx, groupID, reportID, reqOpts...)
+	}, func(v *model.ReportStatus) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // DownloadGroupReport returns a download link for the group report.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.groups.reports.download.download
-func (s *ReportsService) DownloadGroupReport(ctx context.Context, groupID int, reportID string) (
+func (s *ReportsService) DownloadGroupReport(ctx context.Context, groupID int, reportID string, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.DownloadGroupReport")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/reports/%s/download", groupID, reportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/reports/%s/download", groupID, reportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -266,11 +313,12 @@ func (s *ReportsService) DownloadGroupReport(ctx context.Context, groupID int, r
 // GenerateOrganizationReport generates a new organization report.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.reports.post
-func (s *ReportsService) GenerateOrganizationReport(ctx context.Context, req *model.GroupReportGenerateRequest) (
+func (s *ReportsService) GenerateOrganizationReport(ctx context.Context, req *model.GroupReportGenerateRequest, reqOpts ...RequestOption) (
 	*model.ReportStatus, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.GenerateOrganizationReport")
 	res := new(model.ReportStatusResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/reports", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/reports", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -278,19 +326,31 @@ func (s *ReportsService) GenerateOrganizationReport(ctx context.Context, req *mo
 // CheckOrganizationReportStatus returns the status of the organization report generation.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.reports.get
-func (s *ReportsService) CheckOrganizationReportStatus(ctx context.Context, reportID string) (*model.ReportStatus, *Response, error) {
+func (s *ReportsService) CheckOrganizationReportStatus(ctx context.Context, reportID string, reqOpts ...RequestOption) (*model.ReportStatus, *Response, error) {
+	ctx = withOperation(ctx, "ReportsService.CheckOrganizationReportStatus")
 	res := new(model.ReportStatusResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/reports/%s", reportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/reports/%s", reportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// OrganizationReportOperation returns an operation polling the status of the organization report generation
+// with CheckOrganizationReportStatus.
+func (s *ReportsService) OrganizationReportOperation(reportID string, reqOpts ...RequestOption) *Operation[model.ReportStatus] {
+	return newOperation(func(ctx context.Context) (*model.ReportStatus, *Response, error) {
+		return s.CheckOrganizationReportStatus(ctx, reportID, reqOpts...)
+	}, func(v *model.ReportStatus) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // DownloadOrganizationReport returns a download link for the organization report.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.reports.download.download
-func (s *ReportsService) DownloadOrganizationReport(ctx context.Context, reportID string) (*model.DownloadLink, *Response, error) {
+func (s *ReportsService) DownloadOrganizationReport(ctx context.Context, reportID string, reqOpts ...RequestOption) (*model.DownloadLink, *Response, error) {
+	ctx = withOperation(ctx, "ReportsService.DownloadOrganizationReport")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/reports/%s/download", reportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/reports/%s/download", reportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -298,11 +358,12 @@ func (s *ReportsService) DownloadOrganizationReport(ctx context.Context, reportI
 // ListUserSettingsTemplates returns a list of user report settings templates.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Reports/operation/api.users.reports.settings-templates.getMany
-func (s *ReportsService) ListUserSettingsTemplates(ctx context.Context, userID int, opts *model.ListOptions) (
+func (s *ReportsService) ListUserSettingsTemplates(ctx context.Context, userID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.ListUserSettingsTemplates")
 	res := new(model.ReportSettingsTemplateListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates", userID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates", userID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -318,11 +379,12 @@ func (s *ReportsService) ListUserSettingsTemplates(ctx context.Context, userID i
 // GetUserSettingsTemplate returns a user report settings template by its identifier.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Reports/operation/api.users.reports.settings-templates.get
-func (s *ReportsService) GetUserSettingsTemplate(ctx context.Context, userID, settingsTemplateID int) (
+func (s *ReportsService) GetUserSettingsTemplate(ctx context.Context, userID, settingsTemplateID int, reqOpts ...RequestOption) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.GetUserSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates/%d", userID, settingsTemplateID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates/%d", userID, settingsTemplateID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -330,11 +392,12 @@ func (s *ReportsService) GetUserSettingsTemplate(ctx context.Context, userID, se
 // AddUserSettingsTemplate creates a new user report settings template.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Reports/operation/api.users.reports.settings-templates.post
-func (s *ReportsService) AddUserSettingsTemplate(ctx context.Context, userID int, req *model.ReportSettingsTemplateAddRequest) (
+func (s *ReportsService) AddUserSettingsTemplate(ctx context.Context, userID int, req *model.ReportSettingsTemplateAddRequest, reqOpts ...RequestOption) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.AddUserSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates", userID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates", userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -347,19 +410,21 @@ func (s *ReportsService) AddUserSettingsTemplate(ctx context.Context, userID int
 //   - Value (string|int): new value to set.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Reports/operation/api.users.reports.settings-templates.patch
-func (s *ReportsService) EditUserSettingsTemplate(ctx context.Context, userID, settingsTemplateID int, req []*model.UpdateRequest) (
+func (s *ReportsService) EditUserSettingsTemplate(ctx context.Context, userID, settingsTemplateID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.ReportSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ReportsService.EditUserSettingsTemplate")
 	res := new(model.ReportSettingsTemplateResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates/%d", userID, settingsTemplateID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates/%d", userID, settingsTemplateID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
 // DeleteUserSettingsTemplate removes a user report settings template.
 // https://support.crowdin.com/developer/api/v2/#tag/Reports/operation/api.users.reports.settings-templates.delete
-func (s *ReportsService) DeleteUserSettingsTemplate(ctx context.Context, userID, settingsTemplateID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates/%d", userID, settingsTemplateID), nil)
+func (s *ReportsService) DeleteUserSettingsTemplate(ctx context.Context, userID, settingsTemplateID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ReportsService.DeleteUserSettingsTemplate")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/users/%d/reports/settings-templates/%d", userID, settingsTemplateID), nil, reqOpts...)
 }
 
 // getArchivePath returns the path for the report archive.
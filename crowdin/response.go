@@ -0,0 +1,144 @@
+package crowdin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// Response is a Crowdin response that wraps http.Response.
+type Response struct {
+	*http.Response
+
+	Pagination model.Pagination
+
+	// RequestID is the identifier of the request assigned by the server.
+	// Quote it when contacting Crowdin support.
+	RequestID string
+	// Rate is the rate limit status of the client. It is nil if the
+	// server did not return the number of remaining requests.
+	Rate *Rate
+	// ETag is the entity tag of the returned resource.
+	ETag string
+	// RetryAfter is how long to wait before making a new request.
+	// It is zero if the Retry-After header is missing.
+	RetryAfter time.Duration
+	// ServerTiming holds the metrics of the Server-Timing header.
+	ServerTiming []ServerTiming
+	// TotalCount is the total number of items of a list
+	// if returned by the API, otherwise nil.
+	TotalCount *int
+}
+
+// Rate represents the rate limit status of the client.
+type Rate struct {
+	// Limit is the number of requests allowed in the current window,
+	// or zero if unknown.
+	Limit int
+	// Remaining is the number of requests remaining in the current window.
+	Remaining int
+	// Reset is the time when the current window resets.
+	Reset time.Time
+}
+
+// ServerTiming represents a metric of the Server-Timing header.
+type ServerTiming struct {
+	Name        string
+	Duration    time.Duration
+	Description string
+}
+
+// newResponse creates a new Response for the provided http.Response
+// and populates the metadata from the response headers.
+func newResponse(r *http.Response) *Response {
+	response := &Response{
+		Response:     r,
+		RequestID:    requestID(r.Header),
+		Rate:         parseRate(r.Header),
+		ETag:         r.Header.Get("ETag"),
+		ServerTiming: parseServerTiming(r.Header.Values("Server-Timing")),
+	}
+	if d, ok := parseRetryAfter(r.Header.Get("Retry-After")); ok {
+		response.RetryAfter = d
+	}
+	return response
+}
+
+// populatePagination reads the pagination information from the response
+// body and sets it to the Response struct.
+func (r *Response) populatePagination(body []byte) error {
+	p := new(struct {
+		Pagination struct {
+			model.Pagination
+			Total *int `json:"total"`
+		} `json:"pagination"`
+		TotalCount *int `json:"totalCount"`
+	})
+	if err := json.Unmarshal(body, p); err != nil {
+		return err
+	}
+	r.Pagination = p.Pagination.Pagination
+
+	r.TotalCount = p.TotalCount
+	if p.Pagination.Total != nil {
+		r.TotalCount = p.Pagination.Total
+	}
+	return nil
+}
+
+// parseRate parses the X-RateLimit-* headers. The reset header
+// can be either a Unix timestamp or a number of seconds. Without
+// the remaining header the status is unknown, and nil is returned.
+func parseRate(h http.Header) *Rate {
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return nil
+	}
+	limit, _ := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+
+	rate := &Rate{Limit: limit, Remaining: remaining}
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil && reset >= 0 {
+		// Values larger than a year are considered timestamps.
+		if reset > 365*24*60*60 {
+			rate.Reset = time.Unix(reset, 0)
+		} else {
+			rate.Reset = time.Now().Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return rate
+}
+
+// parseServerTiming parses the values of the Server-Timing header,
+// e.g. `db;dur=53, app;dur=47.2;desc="Application"`.
+func parseServerTiming(values []string) []ServerTiming {
+	var timings []ServerTiming
+	for _, v := range values {
+		for _, metric := range strings.Split(v, ",") {
+			params := strings.Split(metric, ";")
+			name := strings.TrimSpace(params[0])
+			if name == "" {
+				continue
+			}
+
+			timing := ServerTiming{Name: name}
+			for _, param := range params[1:] {
+				key, val, _ := strings.Cut(param, "=")
+				val = strings.Trim(strings.TrimSpace(val), `"`)
+				switch strings.ToLower(strings.TrimSpace(key)) {
+				case "dur":
+					if ms, err := strconv.ParseFloat(val, 64); err == nil {
+						timing.Duration = time.Duration(ms * float64(time.Millisecond))
+					}
+				case "desc":
+					timing.Description = val
+				}
+			}
+			timings = append(timings, timing)
+		}
+	}
+	return timings
+}
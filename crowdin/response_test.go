@@ -0,0 +1,114 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponse_metadata(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Crowdin-Request-Id", "request-id")
+		w.Header().Set("X-RateLimit-Limit", "20")
+		w.Header().Set("X-RateLimit-Remaining", "5")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set("ETag", `"abc"`)
+		w.Header().Set("Retry-After", "3")
+		w.Header().Add("Server-Timing", `db;dur=53.5, cache;desc="Cache Read"`)
+		w.Header().Add("Server-Timing", "app;dur=47")
+		fmt.Fprint(w, `{"data": [], "pagination": {"offset": 10, "limit": 25, "total": 42}}`)
+	})
+
+	resp, err := client.Get(context.Background(), "/get", nil, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "request-id", resp.RequestID)
+	require.NotNil(t, resp.Rate)
+	assert.Equal(t, 20, resp.Rate.Limit)
+	assert.Equal(t, 5, resp.Rate.Remaining)
+	assert.True(t, reset.Equal(resp.Rate.Reset))
+	assert.Equal(t, `"abc"`, resp.ETag)
+	assert.Equal(t, 3*time.Second, resp.RetryAfter)
+	assert.Equal(t, []ServerTiming{
+		{Name: "db", Duration: 53500 * time.Microsecond},
+		{Name: "cache", Description: "Cache Read"},
+		{Name: "app", Duration: 47 * time.Millisecond},
+	}, resp.ServerTiming)
+	assert.Equal(t, 10, resp.Pagination.Offset)
+	assert.Equal(t, 25, resp.Pagination.Limit)
+	require.NotNil(t, resp.TotalCount)
+	assert.Equal(t, 42, *resp.TotalCount)
+}
+
+func TestResponse_metadataMissing(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"data": []}`)
+	})
+
+	resp, err := client.Get(context.Background(), "/get", nil, nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, resp.RequestID)
+	assert.Nil(t, resp.Rate)
+	assert.Empty(t, resp.ETag)
+	assert.Zero(t, resp.RetryAfter)
+	assert.Nil(t, resp.ServerTiming)
+	assert.Nil(t, resp.TotalCount)
+}
+
+func TestResponse_rateLimitWithoutRemaining(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "20")
+		w.Header().Set("X-RateLimit-Reset", "30")
+		fmt.Fprint(w, `{"data": []}`)
+	})
+
+	resp, err := client.Get(context.Background(), "/get", nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, resp.Rate, "unknown remaining requests must not read as exhausted")
+}
+
+func TestResponse_errorMetadata(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Request-Id", "request-id")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "30")
+		w.Header().Set("Retry-After", "30")
+		http.Error(w, `{"error": {"code": 429, "message": "Too Many Requests"}}`, http.StatusTooManyRequests)
+	})
+
+	resp, err := client.Get(context.Background(), "/get", nil, nil)
+	require.Error(t, err)
+
+	assert.Equal(t, "request-id", resp.RequestID)
+	require.NotNil(t, resp.Rate)
+	assert.Equal(t, 0, resp.Rate.Remaining)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), resp.Rate.Reset, 5*time.Second)
+	assert.Equal(t, 30*time.Second, resp.RetryAfter)
+}
+
+func TestResponse_totalCount(t *testing.T) {
+	resp := &Response{}
+	require.NoError(t, resp.populatePagination([]byte(`{"data": [], "totalCount": 7}`)))
+	require.NotNil(t, resp.TotalCount)
+	assert.Equal(t, 7, *resp.TotalCount)
+}
@@ -0,0 +1,219 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// RetryPolicy configures automatic retries of failed requests.
+//
+// Requests are retried on 429, 502, 503 and 504 status codes and on
+// transient network errors. Idempotent requests (GET, HEAD, PUT, DELETE
+// and requests marked with the Idempotent option) are retried on any of
+// these conditions. Other requests (POST, PATCH) are only retried when
+// it is known that the server did not process them: on 429 Too Many
+// Requests or when the connection could not be established.
+//
+// The delay between attempts grows exponentially with full jitter and
+// is bounded by MaxBackoff. If the server responds with a Retry-After
+// header, its value is used instead.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first
+	// attempt (default 3).
+	MaxRetries int
+	// MinBackoff is the base delay used for the first retry (default 500ms).
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between two attempts (default 30s).
+	MaxBackoff time.Duration
+}
+
+// WithRetryPolicy enables automatic retries of failed requests
+// using the provided policy. Zero values of the policy fields are
+// replaced with the defaults.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if p.MaxRetries < 0 || p.MinBackoff < 0 || p.MaxBackoff < 0 {
+			return errors.New("retry policy values cannot be negative")
+		}
+		if p.MaxRetries == 0 {
+			p.MaxRetries = defaultMaxRetries
+		}
+		if p.MinBackoff == 0 {
+			p.MinBackoff = defaultMinBackoff
+		}
+		if p.MaxBackoff == 0 {
+			p.MaxBackoff = defaultMaxBackoff
+		}
+		if p.MinBackoff > p.MaxBackoff {
+			return errors.New("retry policy min backoff cannot be greater than max backoff")
+		}
+
+		c.retryPolicy = &p
+		return nil
+	}
+}
+
+type idempotentKey struct{}
+
+// Idempotent marks the request as safe to replay, so that it can be
+// retried on any retryable condition regardless of its HTTP method.
+func Idempotent() RequestOption {
+	return func(r *http.Request) error {
+		withContextValue(r, idempotentKey{}, true)
+		return nil
+	}
+}
+
+// isIdempotent reports whether the request can be safely replayed.
+func isIdempotent(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	ok, _ := r.Context().Value(idempotentKey{}).(bool)
+	return ok
+}
+
+// doWithRetry sends the request and retries it according to the client
+// retry policy.
+func (c *Client) doWithRetry(r *http.Request, v any) (*Response, error) {
+	p := c.retryPolicy
+	ctx := r.Context()
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.roundTrip(r, v)
+		if attempt >= p.MaxRetries || !shouldRetry(r, resp, err) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt)
+		if resp != nil {
+			if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				delay = d
+			}
+		}
+		// Give up right away if the request deadline expires before
+		// the next attempt could be made.
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return resp, err
+		}
+
+		if !rewindBody(r) {
+			return resp, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewindBody resets the request body so that the request can be sent
+// again. It reports whether the body has been rewound.
+func rewindBody(r *http.Request) bool {
+	if r.Body == nil || r.Body == http.NoBody {
+		return true
+	}
+	if r.GetBody == nil {
+		return false
+	}
+	body, err := r.GetBody()
+	if err != nil {
+		return false
+	}
+	r.Body = body
+	return true
+}
+
+// backoff returns the jittered exponential delay for the given attempt.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MaxBackoff
+	if attempt < 32 {
+		if exp := p.MinBackoff << attempt; exp > 0 && exp < d {
+			d = exp
+		}
+	}
+	return rand.N(d) + 1
+}
+
+// shouldRetry reports whether the request should be retried based on
+// the response or the error it produced.
+func shouldRetry(r *http.Request, resp *Response, err error) bool {
+	if r.Context().Err() != nil {
+		return false
+	}
+
+	if resp != nil && resp.Response != nil {
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests:
+			return true
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return isIdempotent(r)
+		}
+		if err == nil || resp.StatusCode >= http.StatusBadRequest {
+			return false
+		}
+	}
+
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	return isIdempotent(r) && isTransientError(err)
+}
+
+// isTransientError reports whether the error is a temporary network failure.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// parseRetryAfter parses the Retry-After header value which can be
+// either a number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
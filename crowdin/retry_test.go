@@ -0,0 +1,267 @@
+package crowdin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRetryClient(t *testing.T) (*Client, *http.ServeMux, func()) {
+	t.Helper()
+
+	client, mux, teardown := setupClient()
+	err := WithRetryPolicy(RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond})(client)
+	require.NoError(t, err)
+
+	return client, mux, teardown
+}
+
+func TestRetry_getRetriedOnServerErrors(t *testing.T) {
+	client, mux, teardown := setupRetryClient(t)
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			fmt.Fprint(w, `{"hello":"world"}`)
+		}
+	})
+
+	var res map[string]string
+	resp, err := client.Get(context.Background(), "/get", nil, &res)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, map[string]string{"hello": "world"}, res)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls))
+}
+
+func TestRetry_maxRetriesExceeded(t *testing.T) {
+	client, mux, teardown := setupRetryClient(t)
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		http.Error(w, `{"error": {"code": 503, "message": "Service Unavailable"}}`, http.StatusServiceUnavailable)
+	})
+
+	resp, err := client.Get(context.Background(), "/get", nil, nil)
+	require.Error(t, err)
+
+	var errResp *model.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.EqualValues(t, 4, atomic.LoadInt32(&calls))
+}
+
+func TestRetry_notRetriedOnClientErrors(t *testing.T) {
+	client, mux, teardown := setupRetryClient(t)
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		http.Error(w, `{"error": {"code": 404, "message": "Not Found"}}`, http.StatusNotFound)
+	})
+
+	_, err := client.Get(context.Background(), "/get", nil, nil)
+	require.Error(t, err)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestRetry_postRetriedOnlyOnTooManyRequests(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		opts      []RequestOption
+		wantCalls int32
+	}{
+		{"503 is not retried", http.StatusServiceUnavailable, nil, 1},
+		{"429 is retried", http.StatusTooManyRequests, nil, 2},
+		{"idempotent 503 is retried", http.StatusServiceUnavailable, []RequestOption{Idempotent()}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, teardown := setupRetryClient(t)
+			defer teardown()
+
+			var calls int32
+			mux.HandleFunc("/post", func(w http.ResponseWriter, r *http.Request) {
+				testJSONBody(t, r, `{"foo":"bar"}`)
+				if atomic.AddInt32(&calls, 1) == 1 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				fmt.Fprint(w, `{}`)
+			})
+
+			_, _ = client.Post(context.Background(), "/post", map[string]string{"foo": "bar"}, nil, tt.opts...)
+			assert.Equal(t, tt.wantCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestRetry_uploadBodyRewound(t *testing.T) {
+	client, mux, teardown := setupRetryClient(t)
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		testBody(t, r, "file content\n")
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1, "fileName": "upload.txt"}}`)
+	})
+
+	file, dir, err := openFile("upload.txt", "file content\n")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer file.Close()
+
+	storage, _, err := client.Storages.Add(context.Background(), file)
+	require.NoError(t, err)
+	assert.Equal(t, 1, storage.ID)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestRetry_nonRewindableBodyNotRetried(t *testing.T) {
+	client, mux, teardown := setupRetryClient(t)
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	body := io.MultiReader(strings.NewReader("content"))
+	_, err := client.Upload(context.Background(), "/upload", body, nil)
+	require.Error(t, err)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestRetry_retryAfterHonoured(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+	require.NoError(t, WithRetryPolicy(RetryPolicy{MaxRetries: 1, MinBackoff: time.Minute, MaxBackoff: time.Minute})(client))
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.Get(ctx, "/get", nil, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestRetry_contextCancelled(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+	require.NoError(t, WithRetryPolicy(RetryPolicy{MinBackoff: time.Minute, MaxBackoff: time.Minute})(client))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		cancel()
+	})
+
+	_, err := client.Get(ctx, "/get", nil, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestRetry_deadlineBeforeNextAttempt(t *testing.T) {
+	client, mux, teardown := setupRetryClient(t)
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "3600")
+		http.Error(w, `{"error": {"code": 429, "message": "Too Many Requests"}}`, http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.Get(ctx, "/get", nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	c, err := NewClient("token", WithRetryPolicy(RetryPolicy{}))
+	require.NoError(t, err)
+	assert.Equal(t, &RetryPolicy{
+		MaxRetries: defaultMaxRetries,
+		MinBackoff: defaultMinBackoff,
+		MaxBackoff: defaultMaxBackoff,
+	}, c.retryPolicy)
+
+	_, err = NewClient("token", WithRetryPolicy(RetryPolicy{MaxRetries: -1}))
+	require.EqualError(t, err, "retry policy values cannot be negative")
+
+	_, err = NewClient("token", WithRetryPolicy(RetryPolicy{MinBackoff: time.Minute, MaxBackoff: time.Second}))
+	require.EqualError(t, err, "retry policy min backoff cannot be greater than max backoff")
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt := 0; attempt < 64; attempt++ {
+		d := p.backoff(attempt)
+		assert.Positive(t, d)
+		assert.LessOrEqual(t, d, time.Second)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"invalid", 0, false},
+		{"Mon, 02 Jan 2006 15:04:05 GMT", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value)
+		assert.Equal(t, tt.ok, ok, tt.value)
+		assert.Equal(t, tt.want, got, tt.value)
+	}
+}
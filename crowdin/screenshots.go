@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"path"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
 )
@@ -21,11 +23,12 @@ type ScreenshotsService struct {
 // GetScreenshot returns a specific screenshot by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.get
-func (s *ScreenshotsService) GetScreenshot(ctx context.Context, projectID, screenshotID int) (
+func (s *ScreenshotsService) GetScreenshot(ctx context.Context, projectID, screenshotID int, reqOpts ...RequestOption) (
 	*model.Screenshot, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.GetScreenshot")
 	res := new(model.ScreenshotResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -33,11 +36,12 @@ func (s *ScreenshotsService) GetScreenshot(ctx context.Context, projectID, scree
 // ListScreenshots returns a list of all screenshots in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.getMany
-func (s *ScreenshotsService) ListScreenshots(ctx context.Context, projectID int, opts *model.ScreenshotListOptions) (
+func (s *ScreenshotsService) ListScreenshots(ctx context.Context, projectID int, opts *model.ScreenshotListOptions, reqOpts ...RequestOption) (
 	[]*model.Screenshot, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.ListScreenshots")
 	res := new(model.ScreenshotListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -53,23 +57,47 @@ func (s *ScreenshotsService) ListScreenshots(ctx context.Context, projectID int,
 // AddScreenshot adds a new screenshot to the project.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.post
-func (s *ScreenshotsService) AddScreenshot(ctx context.Context, projectID int, req *model.ScreenshotAddRequest) (
+func (s *ScreenshotsService) AddScreenshot(ctx context.Context, projectID int, req *model.ScreenshotAddRequest, reqOpts ...RequestOption) (
 	*model.Screenshot, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.AddScreenshot")
 	res := new(model.ScreenshotResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// AddScreenshotFromReader uploads the image read from `r` to the storage and
+// adds it as a new screenshot to the project. The storage is deleted afterwards.
+// If `req.Name` is empty, `name` is used as the screenshot name.
+//
+// https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.post
+func (s *ScreenshotsService) AddScreenshotFromReader(ctx context.Context, projectID int, name string, r io.Reader, req *model.ScreenshotAddRequest,
+	reqOpts ...RequestOption,
+) (*model.Screenshot, *Response, error) {
+	ctx = withOperation(ctx, "ScreenshotsService.AddScreenshotFromReader")
+	return withStorage(ctx, s.client.Storages, name, r, reqOpts, func(storageID int) (*model.Screenshot, *Response, error) {
+		add := model.ScreenshotAddRequest{}
+		if req != nil {
+			add = *req
+		}
+		add.StorageID = storageID
+		if add.Name == "" {
+			add.Name = path.Base(name)
+		}
+		return s.AddScreenshot(ctx, projectID, &add, reqOpts...)
+	})
+}
+
 // UpdateScreenshot updates a specific screenshot by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.put
-func (s *ScreenshotsService) UpdateScreenshot(ctx context.Context, projectID, screenshotID int, req *model.ScreenshotUpdateRequest) (
+func (s *ScreenshotsService) UpdateScreenshot(ctx context.Context, projectID, screenshotID int, req *model.ScreenshotUpdateRequest, reqOpts ...RequestOption) (
 	*model.Screenshot, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.UpdateScreenshot")
 	res := new(model.ScreenshotResponse)
-	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), req, res)
+	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -83,11 +111,12 @@ func (s *ScreenshotsService) UpdateScreenshot(ctx context.Context, projectID, sc
 //   - value (string): New value for the field. Must be a string.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.patch
-func (s *ScreenshotsService) EditScreenshot(ctx context.Context, projectID, screenshotID int, req []*model.UpdateRequest) (
+func (s *ScreenshotsService) EditScreenshot(ctx context.Context, projectID, screenshotID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Screenshot, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.EditScreenshot")
 	res := new(model.ScreenshotResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -95,18 +124,20 @@ func (s *ScreenshotsService) EditScreenshot(ctx context.Context, projectID, scre
 // DeleteScreenshot deletes a specific screenshot by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.delete
-func (s *ScreenshotsService) DeleteScreenshot(ctx context.Context, projectID, screenshotID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), nil)
+func (s *ScreenshotsService) DeleteScreenshot(ctx context.Context, projectID, screenshotID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ScreenshotsService.DeleteScreenshot")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d", projectID, screenshotID), nil, reqOpts...)
 }
 
 // ListTags returns a list of all tags for the screenshot.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.getMany
-func (s *ScreenshotsService) ListTags(ctx context.Context, projectID, screenshotID int, opts *model.ListOptions) (
+func (s *ScreenshotsService) ListTags(ctx context.Context, projectID, screenshotID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.Tag, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.ListTags")
 	res := new(model.TagListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -122,12 +153,13 @@ func (s *ScreenshotsService) ListTags(ctx context.Context, projectID, screenshot
 // GetTag returns a specific tag by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.screenshots.tags.get
-func (s *ScreenshotsService) GetTag(ctx context.Context, projectID, screenshotID, tagID int) (
+func (s *ScreenshotsService) GetTag(ctx context.Context, projectID, screenshotID, tagID int, reqOpts ...RequestOption) (
 	*model.Tag, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.GetTag")
 	res := new(model.TagResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags/%d", projectID, screenshotID, tagID)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -135,12 +167,13 @@ func (s *ScreenshotsService) GetTag(ctx context.Context, projectID, screenshotID
 // AddTag adds a new tag to the screenshot.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.post
-func (s *ScreenshotsService) AddTag(ctx context.Context, projectID, screenshotID int, req *model.TagAddRequest) (
+func (s *ScreenshotsService) AddTag(ctx context.Context, projectID, screenshotID int, req *model.TagAddRequest, reqOpts ...RequestOption) (
 	*model.Tag, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.AddTag")
 	res := new(model.TagResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID)
-	resp, err := s.client.Post(ctx, path, req, res)
+	resp, err := s.client.Post(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -148,9 +181,10 @@ func (s *ScreenshotsService) AddTag(ctx context.Context, projectID, screenshotID
 // ReplaceTags replaces all tags on the screenshot.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.putMany
-func (s *ScreenshotsService) ReplaceTags(ctx context.Context, projectID, screenshotID int, req []*model.ReplaceTagsRequest) (
+func (s *ScreenshotsService) ReplaceTags(ctx context.Context, projectID, screenshotID int, req []*model.ReplaceTagsRequest, reqOpts ...RequestOption) (
 	*Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.ReplaceTags")
 	if len(req) == 0 {
 		return nil, errors.New("request is required")
 	}
@@ -161,16 +195,17 @@ func (s *ScreenshotsService) ReplaceTags(ctx context.Context, projectID, screens
 		}
 	}
 
-	return s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), req, nil)
+	return s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), req, nil, reqOpts...)
 }
 
 // AutoTag automatically tags the screenshot with the source strings that are displayed on it.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.putMany
-func (s *ScreenshotsService) AutoTag(ctx context.Context, projectID, screenshotID int, req *model.AutoTagRequest) (
+func (s *ScreenshotsService) AutoTag(ctx context.Context, projectID, screenshotID int, req *model.AutoTagRequest, reqOpts ...RequestOption) (
 	*Response, error,
 ) {
-	return s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), req, nil)
+	ctx = withOperation(ctx, "ScreenshotsService.AutoTag")
+	return s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), req, nil, reqOpts...)
 }
 
 // EditTag edit a specific tag by its identifier.
@@ -182,12 +217,13 @@ func (s *ScreenshotsService) AutoTag(ctx context.Context, projectID, screenshotI
 //   - value (string or int): New value for the field. Must be a string or int.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.patch
-func (s *ScreenshotsService) EditTag(ctx context.Context, projectID, screenshotID, tagID int, req []*model.UpdateRequest) (
+func (s *ScreenshotsService) EditTag(ctx context.Context, projectID, screenshotID, tagID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Tag, *Response, error,
 ) {
+	ctx = withOperation(ctx, "ScreenshotsService.EditTag")
 	res := new(model.TagResponse)
 	path := fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags/%d", projectID, screenshotID, tagID)
-	resp, err := s.client.Patch(ctx, path, req, res)
+	resp, err := s.client.Patch(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -195,13 +231,15 @@ func (s *ScreenshotsService) EditTag(ctx context.Context, projectID, screenshotI
 // ClearTags deletes all tags from the screenshot.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.deleteMany
-func (s *ScreenshotsService) ClearTags(ctx context.Context, projectID, screenshotID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), nil)
+func (s *ScreenshotsService) ClearTags(ctx context.Context, projectID, screenshotID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ScreenshotsService.ClearTags")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags", projectID, screenshotID), nil, reqOpts...)
 }
 
 // DeleteTag deletes a specific tag by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.screenshots.tags.delete
-func (s *ScreenshotsService) DeleteTag(ctx context.Context, projectID, screenshotID, tagID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags/%d", projectID, screenshotID, tagID), nil)
+func (s *ScreenshotsService) DeleteTag(ctx context.Context, projectID, screenshotID, tagID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "ScreenshotsService.DeleteTag")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/screenshots/%d/tags/%d", projectID, screenshotID, tagID), nil, reqOpts...)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
@@ -781,3 +782,20 @@ func TestScreenshotsService_DeleteTag(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 }
+
+func TestScreenshotsService_AddScreenshotFromReader(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "image")
+	mux.HandleFunc("/api/v2/projects/1/screenshots", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, `{"storageId": 61, "name": "home.png"}`)
+		fmt.Fprint(w, `{"data": {"id": 2, "name": "home.png"}}`)
+	})
+
+	screenshot, _, err := client.Screenshots.AddScreenshotFromReader(context.Background(), 1, "home.png", strings.NewReader("image"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, screenshot.ID)
+	assert.Equal(t, []string{"61"}, *deleted)
+}
@@ -15,35 +15,39 @@ type SecurityLogsService struct {
 }
 
 // https://developer.crowdin.com/api/v2/#operation/api.users.security-logs.getMany
-func (s *SecurityLogsService) ListUserLogs(ctx context.Context, userID int, opts *model.SecurityLogsListOptions) (
+func (s *SecurityLogsService) ListUserLogs(ctx context.Context, userID int, opts *model.SecurityLogsListOptions, reqOpts ...RequestOption) (
 	[]*model.SecurityLog, *Response, error,
 ) {
-	return s.listSecurityLogs(ctx, fmt.Sprintf("/api/v2/users/%d/security-logs", userID), opts)
+	ctx = withOperation(ctx, "SecurityLogsService.ListUserLogs")
+	return s.listSecurityLogs(ctx, fmt.Sprintf("/api/v2/users/%d/security-logs", userID), opts, reqOpts...)
 }
 
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.security-logs.getMany
-func (s *SecurityLogsService) ListOrganizationLogs(ctx context.Context, opts *model.SecurityLogsListOptions) (
+func (s *SecurityLogsService) ListOrganizationLogs(ctx context.Context, opts *model.SecurityLogsListOptions, reqOpts ...RequestOption) (
 	[]*model.SecurityLog, *Response, error,
 ) {
-	return s.listSecurityLogs(ctx, "/api/v2/security-logs", opts)
+	ctx = withOperation(ctx, "SecurityLogsService.ListOrganizationLogs")
+	return s.listSecurityLogs(ctx, "/api/v2/security-logs", opts, reqOpts...)
 }
 
 // https://developer.crowdin.com/api/v2/#operation/api.users.security-logs.get
-func (s *SecurityLogsService) GetUserLog(ctx context.Context, userID, logID int) (*model.SecurityLog, *Response, error) {
-	return s.getSecurityLog(ctx, fmt.Sprintf("/api/v2/users/%d/security-logs/%d", userID, logID))
+func (s *SecurityLogsService) GetUserLog(ctx context.Context, userID, logID int, reqOpts ...RequestOption) (*model.SecurityLog, *Response, error) {
+	ctx = withOperation(ctx, "SecurityLogsService.GetUserLog")
+	return s.getSecurityLog(ctx, fmt.Sprintf("/api/v2/users/%d/security-logs/%d", userID, logID), reqOpts...)
 }
 
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.security-logs.get
-func (s *SecurityLogsService) GetOrganizationLog(ctx context.Context, logID int) (*model.SecurityLog, *Response, error) {
-	return s.getSecurityLog(ctx, fmt.Sprintf("/api/v2/security-logs/%d", logID))
+func (s *SecurityLogsService) GetOrganizationLog(ctx context.Context, logID int, reqOpts ...RequestOption) (*model.SecurityLog, *Response, error) {
+	ctx = withOperation(ctx, "SecurityLogsService.GetOrganizationLog")
+	return s.getSecurityLog(ctx, fmt.Sprintf("/api/v2/security-logs/%d", logID), reqOpts...)
 }
 
 // Generic method to list security logs.
-func (s *SecurityLogsService) listSecurityLogs(ctx context.Context, path string, opts *model.SecurityLogsListOptions) (
+func (s *SecurityLogsService) listSecurityLogs(ctx context.Context, path string, opts *model.SecurityLogsListOptions, reqOpts ...RequestOption) (
 	[]*model.SecurityLog, *Response, error,
 ) {
 	res := new(model.SecurityLogsListResponse)
-	resp, err := s.client.Get(ctx, path, opts, res)
+	resp, err := s.client.Get(ctx, path, opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -57,9 +61,9 @@ func (s *SecurityLogsService) listSecurityLogs(ctx context.Context, path string,
 }
 
 // Generic method to get a security log.
-func (s *SecurityLogsService) getSecurityLog(ctx context.Context, path string) (*model.SecurityLog, *Response, error) {
+func (s *SecurityLogsService) getSecurityLog(ctx context.Context, path string, reqOpts ...RequestOption) (*model.SecurityLog, *Response, error) {
 	res := new(model.SecurityLogResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -3,6 +3,8 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"io"
+	"path"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
 )
@@ -22,11 +24,12 @@ type SourceFilesService struct {
 // ListDirectories returns a list of directories in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.getMany
-func (s *SourceFilesService) ListDirectories(ctx context.Context, projectID int, opts *model.DirectoryListOptions) (
+func (s *SourceFilesService) ListDirectories(ctx context.Context, projectID int, opts *model.DirectoryListOptions, reqOpts ...RequestOption) (
 	[]*model.Directory, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.ListDirectories")
 	res := new(model.DirectoryListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/directories", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/directories", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -42,9 +45,10 @@ func (s *SourceFilesService) ListDirectories(ctx context.Context, projectID int,
 // GetDirectory returns a single directory in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.get
-func (s *SourceFilesService) GetDirectory(ctx context.Context, projectID, directoryID int) (*model.Directory, *Response, error) {
+func (s *SourceFilesService) GetDirectory(ctx context.Context, projectID, directoryID int, reqOpts ...RequestOption) (*model.Directory, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.GetDirectory")
 	res := new(model.DirectoryGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -52,11 +56,12 @@ func (s *SourceFilesService) GetDirectory(ctx context.Context, projectID, direct
 // AddDirectory creates a new directory in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.post
-func (s *SourceFilesService) AddDirectory(ctx context.Context, projectID int, req *model.DirectoryAddRequest) (
+func (s *SourceFilesService) AddDirectory(ctx context.Context, projectID int, req *model.DirectoryAddRequest, reqOpts ...RequestOption) (
 	*model.Directory, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.AddDirectory")
 	res := new(model.DirectoryGetResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/directories", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/directories", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -70,11 +75,12 @@ func (s *SourceFilesService) AddDirectory(ctx context.Context, projectID int, re
 //   - value: The value to be used within the operations. The value must be one of string or integer.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.patch
-func (s *SourceFilesService) EditDirectory(ctx context.Context, projectID, directoryID int, req []*model.UpdateRequest) (
+func (s *SourceFilesService) EditDirectory(ctx context.Context, projectID, directoryID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Directory, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.EditDirectory")
 	res := new(model.DirectoryGetResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -82,18 +88,20 @@ func (s *SourceFilesService) EditDirectory(ctx context.Context, projectID, direc
 // DeleteDirectory deletes a directory in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.delete
-func (s *SourceFilesService) DeleteDirectory(ctx context.Context, projectID, directoryID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID), nil)
+func (s *SourceFilesService) DeleteDirectory(ctx context.Context, projectID, directoryID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.DeleteDirectory")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d", projectID, directoryID), nil, reqOpts...)
 }
 
 // ListFiles returns a list of files in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.getMany
-func (s *SourceFilesService) ListFiles(ctx context.Context, projectID int, opts *model.FileListOptions) (
+func (s *SourceFilesService) ListFiles(ctx context.Context, projectID int, opts *model.FileListOptions, reqOpts ...RequestOption) (
 	[]*model.File, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.ListFiles")
 	res := new(model.FileListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -109,9 +117,10 @@ func (s *SourceFilesService) ListFiles(ctx context.Context, projectID int, opts
 // GetFile returns a single file in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.get
-func (s *SourceFilesService) GetFile(ctx context.Context, projectID, fileID int) (*model.File, *Response, error) {
+func (s *SourceFilesService) GetFile(ctx context.Context, projectID, fileID int, reqOpts ...RequestOption) (*model.File, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.GetFile")
 	res := new(model.FileGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -119,38 +128,81 @@ func (s *SourceFilesService) GetFile(ctx context.Context, projectID, fileID int)
 // AddFile adds a new file to the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.post
-func (s *SourceFilesService) AddFile(ctx context.Context, projectID int, req *model.FileAddRequest) (
+func (s *SourceFilesService) AddFile(ctx context.Context, projectID int, req *model.FileAddRequest, reqOpts ...RequestOption) (
 	*model.File, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.AddFile")
 	res := new(model.FileGetResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/files", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/files", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// AddFileFromReader uploads the content read from `r` to the storage and
+// adds it as a new file to the project. The storage is deleted afterwards.
+// If `req.Name` is empty, `name` is used as the file name.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.files.post
+func (s *SourceFilesService) AddFileFromReader(ctx context.Context, projectID int, name string, r io.Reader, req *model.FileAddRequest,
+	reqOpts ...RequestOption,
+) (*model.File, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.AddFileFromReader")
+	return withStorage(ctx, s.client.Storages, name, r, reqOpts, func(storageID int) (*model.File, *Response, error) {
+		add := model.FileAddRequest{}
+		if req != nil {
+			add = *req
+		}
+		add.StorageID = storageID
+		if add.Name == "" {
+			add.Name = path.Base(name)
+		}
+		return s.AddFile(ctx, projectID, &add, reqOpts...)
+	})
+}
+
 // UpdateOrRestoreFile updates a file in the project or restores it to one
 // of the previous revisions.
 // For updating the file, use the `storageId` body parameter.
 // For restoring the file, use the `revisionId` body parameter.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.put
-func (s *SourceFilesService) UpdateOrRestoreFile(ctx context.Context, projectID, fileID int, req *model.FileUpdateRestoreRequest) (
+func (s *SourceFilesService) UpdateOrRestoreFile(ctx context.Context, projectID, fileID int, req *model.FileUpdateRestoreRequest, reqOpts ...RequestOption) (
 	*model.File, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.UpdateOrRestoreFile")
 	res := new(model.FileGetResponse)
-	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), req, res)
+	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// UpdateFileFromReader uploads the content read from `r` to the storage and
+// updates the file in the project with it. The storage is deleted afterwards.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.files.put
+func (s *SourceFilesService) UpdateFileFromReader(ctx context.Context, projectID, fileID int, name string, r io.Reader, req *model.FileUpdateRestoreRequest,
+	reqOpts ...RequestOption,
+) (*model.File, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.UpdateFileFromReader")
+	return withStorage(ctx, s.client.Storages, name, r, reqOpts, func(storageID int) (*model.File, *Response, error) {
+		update := model.FileUpdateRestoreRequest{}
+		if req != nil {
+			update = *req
+		}
+		update.StorageID, update.RevisionID = storageID, 0
+		return s.UpdateOrRestoreFile(ctx, projectID, fileID, &update, reqOpts...)
+	})
+}
+
 // EditFile updates a file in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.patch
-func (s *SourceFilesService) EditFile(ctx context.Context, projectID, fileID int, req []*model.UpdateRequest) (
+func (s *SourceFilesService) EditFile(ctx context.Context, projectID, fileID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.File, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.EditFile")
 	res := new(model.FileGetResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -158,16 +210,18 @@ func (s *SourceFilesService) EditFile(ctx context.Context, projectID, fileID int
 // DeleteFile deletes a file in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.delete
-func (s *SourceFilesService) DeleteFile(ctx context.Context, projectID, fileID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), nil)
+func (s *SourceFilesService) DeleteFile(ctx context.Context, projectID, fileID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.DeleteFile")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d", projectID, fileID), nil, reqOpts...)
 }
 
 // DownloadFilePreview returns a download link for a specific file preview.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.preview.get
-func (s *SourceFilesService) DownloadFilePreview(ctx context.Context, projectID, fileID int) (*model.DownloadLink, *Response, error) {
+func (s *SourceFilesService) DownloadFilePreview(ctx context.Context, projectID, fileID int, reqOpts ...RequestOption) (*model.DownloadLink, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.DownloadFilePreview")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/preview", projectID, fileID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/preview", projectID, fileID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -175,9 +229,10 @@ func (s *SourceFilesService) DownloadFilePreview(ctx context.Context, projectID,
 // DownloadFile returns a download link for a specific file.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.download.get
-func (s *SourceFilesService) DownloadFile(ctx context.Context, projectID, fileID int) (*model.DownloadLink, *Response, error) {
+func (s *SourceFilesService) DownloadFile(ctx context.Context, projectID, fileID int, reqOpts ...RequestOption) (*model.DownloadLink, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.DownloadFile")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/download", projectID, fileID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/download", projectID, fileID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -185,11 +240,12 @@ func (s *SourceFilesService) DownloadFile(ctx context.Context, projectID, fileID
 // ListFileRevisions returns a list of file revisions.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.revisions.getMany
-func (s *SourceFilesService) ListFileRevisions(ctx context.Context, projectID, fileID int, opts *model.ListOptions) (
+func (s *SourceFilesService) ListFileRevisions(ctx context.Context, projectID, fileID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.FileRevision, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.ListFileRevisions")
 	res := new(model.FileRevisionListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/revisions", projectID, fileID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/revisions", projectID, fileID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -205,9 +261,10 @@ func (s *SourceFilesService) ListFileRevisions(ctx context.Context, projectID, f
 // GetFileRevision returns a single file revision.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.revisions.get
-func (s *SourceFilesService) GetFileRevision(ctx context.Context, projectID, fileID, revisionID int) (*model.FileRevision, *Response, error) {
+func (s *SourceFilesService) GetFileRevision(ctx context.Context, projectID, fileID, revisionID int, reqOpts ...RequestOption) (*model.FileRevision, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.GetFileRevision")
 	res := new(model.FileRevisionResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/revisions/%d", projectID, fileID, revisionID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/revisions/%d", projectID, fileID, revisionID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -215,11 +272,12 @@ func (s *SourceFilesService) GetFileRevision(ctx context.Context, projectID, fil
 // ListReviewedBuilds returns a list of reviewed source files builds.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.strings.reviewed-builds.getMany
-func (s *SourceFilesService) ListReviewedBuilds(ctx context.Context, projectID int, opts *model.ReviewedBuildListOptions) (
+func (s *SourceFilesService) ListReviewedBuilds(ctx context.Context, projectID int, opts *model.ReviewedBuildListOptions, reqOpts ...RequestOption) (
 	[]*model.ReviewedBuild, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.ListReviewedBuilds")
 	res := new(model.ReviewedBuildListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -235,21 +293,33 @@ func (s *SourceFilesService) ListReviewedBuilds(ctx context.Context, projectID i
 // CheckReviewedBuildStatus checks the status of a specific reviewed source files build.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.strings.reviewed-builds.get
-func (s *SourceFilesService) CheckReviewedBuildStatus(ctx context.Context, projectID, buildID int) (*model.ReviewedBuild, *Response, error) {
+func (s *SourceFilesService) CheckReviewedBuildStatus(ctx context.Context, projectID, buildID int, reqOpts ...RequestOption) (*model.ReviewedBuild, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.CheckReviewedBuildStatus")
 	res := new(model.ReviewedBuildResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds/%d", projectID, buildID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds/%d", projectID, buildID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// ReviewedBuildOperation returns an operation polling the status of the reviewed source files build
+// with CheckReviewedBuildStatus.
+func (s *SourceFilesService) ReviewedBuildOperation(projectID, buildID int, reqOpts ...RequestOption) *Operation[model.ReviewedBuild] {
+	return newOperation(func(ctx context.Context) (*model.ReviewedBuild, *Response, error) {
+		return s.CheckReviewedBuildStatus(ctx, projectID, buildID, reqOpts...)
+	}, func(v *model.ReviewedBuild) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // BuildReviewedFiles starts a new build of reviewed source files.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.strings.reviewed-builds.post
-func (s *SourceFilesService) BuildReviewedFiles(ctx context.Context, projectID int, req *model.ReviewedBuildRequest) (
+func (s *SourceFilesService) BuildReviewedFiles(ctx context.Context, projectID int, req *model.ReviewedBuildRequest, reqOpts ...RequestOption) (
 	*model.ReviewedBuild, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceFilesService.BuildReviewedFiles")
 	res := new(model.ReviewedBuildResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -257,9 +327,10 @@ func (s *SourceFilesService) BuildReviewedFiles(ctx context.Context, projectID i
 // DownloadReviewedBuild returns a download link for a specific reviewed source files build.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.strings.reviewed-builds.download.download
-func (s *SourceFilesService) DownloadReviewedBuild(ctx context.Context, projectID, buildID int) (*model.DownloadLink, *Response, error) {
+func (s *SourceFilesService) DownloadReviewedBuild(ctx context.Context, projectID, buildID int, reqOpts ...RequestOption) (*model.DownloadLink, *Response, error) {
+	ctx = withOperation(ctx, "SourceFilesService.DownloadReviewedBuild")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds/%d/download", projectID, buildID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/reviewed-builds/%d/download", projectID, buildID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
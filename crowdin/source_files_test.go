@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
@@ -1244,3 +1245,54 @@ func TestSourceFilesService_BuildReviewedFiles(t *testing.T) {
 	assert.Equal(t, expected, build)
 	assert.NotNil(t, resp)
 }
+
+func TestSourceFilesService_AddFileFromReader(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "key=value")
+	mux.HandleFunc("/api/v2/projects/1/files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, `{"storageId": 61, "name": "messages.properties", "directoryId": 4}`)
+		fmt.Fprint(w, `{"data": {"id": 44, "name": "messages.properties"}}`)
+	})
+
+	file, _, err := client.SourceFiles.AddFileFromReader(context.Background(), 1, "i18n/messages.properties",
+		strings.NewReader("key=value"), &model.FileAddRequest{DirectoryID: 4})
+	require.NoError(t, err)
+	assert.Equal(t, 44, file.ID)
+	assert.Equal(t, []string{"61"}, *deleted)
+}
+
+func TestSourceFilesService_AddFileFromReader_deletesStorageOnError(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "key=value")
+	mux.HandleFunc("/api/v2/projects/1/files", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"error": {"code": 409, "message": "File already exists"}}`)
+	})
+
+	_, _, err := client.SourceFiles.AddFileFromReader(context.Background(), 1, "messages.properties", strings.NewReader("key=value"), nil)
+	require.ErrorIs(t, err, ErrConflict)
+	assert.Equal(t, []string{"61"}, *deleted)
+}
+
+func TestSourceFilesService_UpdateFileFromReader(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "key=new value")
+	mux.HandleFunc("/api/v2/projects/1/files/44", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testJSONBody(t, r, `{"storageId": 61, "updateOption": "keep_translations"}`)
+		fmt.Fprint(w, `{"data": {"id": 44, "name": "messages.properties", "revisionId": 2}}`)
+	})
+
+	file, _, err := client.SourceFiles.UpdateFileFromReader(context.Background(), 1, 44, "messages.properties", strings.NewReader("key=new value"),
+		&model.FileUpdateRestoreRequest{UpdateOption: "keep_translations"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, file.RevisionID)
+	assert.Equal(t, []string{"61"}, *deleted)
+}
@@ -22,11 +22,12 @@ type SourceStringsService struct {
 // Use optional parameters to filter the list.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings.getMany
-func (s *SourceStringsService) List(ctx context.Context, projectID int, opts *model.SourceStringsListOptions) (
+func (s *SourceStringsService) List(ctx context.Context, projectID int, opts *model.SourceStringsListOptions, reqOpts ...RequestOption) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceStringsService.List")
 	res := new(model.SourceStringsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -42,11 +43,12 @@ func (s *SourceStringsService) List(ctx context.Context, projectID int, opts *mo
 // Get returns a specific source string by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings.get
-func (s *SourceStringsService) Get(ctx context.Context, projectID, stringID int, opts *model.SourceStringsGetOptions) (
+func (s *SourceStringsService) Get(ctx context.Context, projectID, stringID int, opts *model.SourceStringsGetOptions, reqOpts ...RequestOption) (
 	*model.SourceString, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceStringsService.Get")
 	res := new(model.SourceStringsGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID), opts, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -54,11 +56,12 @@ func (s *SourceStringsService) Get(ctx context.Context, projectID, stringID int,
 // Add creates a new string.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings.post
-func (s *SourceStringsService) Add(ctx context.Context, projectID int, req *model.SourceStringsAddRequest) (
+func (s *SourceStringsService) Add(ctx context.Context, projectID int, req *model.SourceStringsAddRequest, reqOpts ...RequestOption) (
 	*model.SourceString, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceStringsService.Add")
 	res := new(model.SourceStringsGetResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -74,11 +77,12 @@ func (s *SourceStringsService) Add(ctx context.Context, projectID int, req *mode
 //     boolean or map
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings.batchPatch
-func (s *SourceStringsService) BatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest) (
+func (s *SourceStringsService) BatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceStringsService.BatchOperations")
 	res := new(model.SourceStringsListResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/strings", projectID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -102,11 +106,12 @@ func (s *SourceStringsService) BatchOperations(ctx context.Context, projectID in
 //     boolean or object
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings.patch
-func (s *SourceStringsService) Edit(ctx context.Context, projectID, stringID int, req []*model.UpdateRequest) (
+func (s *SourceStringsService) Edit(ctx context.Context, projectID, stringID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.SourceString, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceStringsService.Edit")
 	res := new(model.SourceStringsGetResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -114,30 +119,43 @@ func (s *SourceStringsService) Edit(ctx context.Context, projectID, stringID int
 // Delete removes a specific string by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.strings.delete
-func (s *SourceStringsService) Delete(ctx context.Context, projectID, stringID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID), nil)
+func (s *SourceStringsService) Delete(ctx context.Context, projectID, stringID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "SourceStringsService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/%d", projectID, stringID), nil, reqOpts...)
 }
 
 // GetUploadStatus returns the status of the uploaded strings.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.strings.uploads.get
-func (s *SourceStringsService) GetUploadStatus(ctx context.Context, projectID int, uploadID string) (
+func (s *SourceStringsService) GetUploadStatus(ctx context.Context, projectID int, uploadID string, reqOpts ...RequestOption) (
 	*model.SourceStringsUpload, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceStringsService.GetUploadStatus")
 	res := new(model.SourceStringsUploadResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/uploads/%s", projectID, uploadID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/uploads/%s", projectID, uploadID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// UploadOperation returns an operation polling the status of the strings upload
+// with GetUploadStatus.
+func (s *SourceStringsService) UploadOperation(projectID int, uploadID string, reqOpts ...RequestOption) *Operation[model.SourceStringsUpload] {
+	return newOperation(func(ctx context.Context) (*model.SourceStringsUpload, *Response, error) {
+		return s.GetUploadStatus(ctx, projectID, uploadID, reqOpts...)
+	}, func(v *model.SourceStringsUpload) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // Upload uploads strings to the project.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.strings.uploads.post
-func (s *SourceStringsService) Upload(ctx context.Context, projectID int, req *model.SourceStringsUploadRequest) (
+func (s *SourceStringsService) Upload(ctx context.Context, projectID int, req *model.SourceStringsUploadRequest, reqOpts ...RequestOption) (
 	*model.SourceStringsUpload, *Response, error,
 ) {
+	ctx = withOperation(ctx, "SourceStringsService.Upload")
 	res := new(model.SourceStringsUploadResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/uploads", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/strings/uploads", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"mime"
+	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
 )
@@ -31,20 +36,122 @@ type StorageService struct {
 // ZIP files are not supported.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.storages.post
-func (s *StorageService) Add(ctx context.Context, file *os.File) (*model.Storage, *Response, error) {
+func (s *StorageService) Add(ctx context.Context, file *os.File, reqOpts ...RequestOption) (*model.Storage, *Response, error) {
+	ctx = withOperation(ctx, "StorageService.Add")
 	if file == nil {
 		return nil, nil, errors.New("file is required")
 	}
 
+	return s.addReader(ctx, filepath.Base(file.Name()), file, nil, reqOpts...)
+}
+
+// StorageOptions specifies the optional parameters to the
+// StorageService.AddReader and StorageService.AddFS methods.
+type StorageOptions struct {
+	// ContentType is the media type of the content. Defaults to the type
+	// of the file name extension or "application/octet-stream".
+	ContentType string
+	// Size is the size of the content in bytes. If zero, the size is
+	// detected from the reader (bytes.Reader, os.File, fs.File, io.Seeker
+	// and others); if it cannot be detected, the content is sent chunked.
+	Size int64
+	// Progress is called with the number of bytes uploaded and the
+	// total size of the content, or -1 if the size is unknown.
+	Progress func(written, total int64)
+}
+
+// ErrZipUpload is returned when a ZIP archive is uploaded to the storage.
+var ErrZipUpload = errors.New("ZIP archives are not supported by the storage")
+
+// AddReader adds a new file with the content read from `r` to the storage.
+//
+// `name` is the name of the file. ZIP archives are rejected by their
+// ".zip" extension or media type with ErrZipUpload; the formats stored
+// in ZIP containers, e.g. DOCX or IDML, are uploaded as is.
+// If `r` is an io.ReadSeeker, the upload can be retried.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.storages.post
+func (s *StorageService) AddReader(ctx context.Context, name string, r io.Reader, opts *StorageOptions, reqOpts ...RequestOption) (
+	*model.Storage, *Response, error,
+) {
+	ctx = withOperation(ctx, "StorageService.AddReader")
+	return s.addReader(ctx, name, r, opts, reqOpts...)
+}
+
+// addReader implements AddReader, leaving the operation of ctx to the caller.
+func (s *StorageService) addReader(ctx context.Context, name string, r io.Reader, opts *StorageOptions, reqOpts ...RequestOption) (
+	*model.Storage, *Response, error,
+) {
+	if name == "" {
+		return nil, nil, errors.New("name is required")
+	}
+	if r == nil {
+		return nil, nil, errors.New("reader is required")
+	}
+	if opts == nil {
+		opts = &StorageOptions{}
+	}
+
+	contentType := opts.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(name))
+	}
+	if strings.EqualFold(filepath.Ext(name), ".zip") || isZipType(contentType) {
+		return nil, nil, ErrZipUpload
+	}
+
+	size := opts.Size
+	if size <= 0 {
+		size = contentSize(r)
+	}
+	if opts.Progress != nil {
+		r = newProgressReader(r, size, opts.Progress)
+	}
+
 	res := new(model.StorageGetResponse)
-	resp, err := s.client.Upload(ctx, "/api/v2/storages", file, res,
-		Header("Content-Type", mime.TypeByExtension(filepath.Ext(file.Name()))),
-		Header("Crowdin-API-FileName", url.QueryEscape(filepath.Base(file.Name()))),
-	)
+	uploadOpts := []RequestOption{
+		Header("Content-Type", contentType),
+		Header("Crowdin-API-FileName", url.QueryEscape(filepath.Base(name))),
+		// Replaying an upload at worst leaves an unused storage that expires in 24 hours.
+		Idempotent(),
+	}
+	if size >= 0 {
+		uploadOpts = append(uploadOpts, contentLength(size))
+	}
+	resp, err := s.client.Upload(ctx, "/api/v2/storages", r, res, append(uploadOpts, reqOpts...)...)
 
 	return res.Data, resp, err
 }
 
+// AddFS adds the file `name` of the file system to the storage.
+// See AddReader for the details.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.storages.post
+func (s *StorageService) AddFS(ctx context.Context, fsys fs.FS, name string, opts *StorageOptions, reqOpts ...RequestOption) (
+	*model.Storage, *Response, error,
+) {
+	ctx = withOperation(ctx, "StorageService.AddFS")
+	if fsys == nil {
+		return nil, nil, errors.New("file system is required")
+	}
+
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, nil, fmt.Errorf("%s is not a regular file", name)
+	}
+
+	return s.addReader(ctx, path.Base(name), f, opts, reqOpts...)
+}
+
 // List returns a list of storages.
 // opts (model.ListOptions) can be used to control pagination. If nil, default values will be used.
 //
@@ -52,9 +159,10 @@ func (s *StorageService) Add(ctx context.Context, file *os.File) (*model.Storage
 //	offset: A starting offset in the collection of items (default 0).
 //
 // https://developer.crowdin.com/api/v2/#operation/api.storages.getMany
-func (s *StorageService) List(ctx context.Context, opts *model.ListOptions) ([]*model.Storage, *Response, error) {
+func (s *StorageService) List(ctx context.Context, opts *model.ListOptions, reqOpts ...RequestOption) ([]*model.Storage, *Response, error) {
+	ctx = withOperation(ctx, "StorageService.List")
 	res := new(model.StorageListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/storages", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/storages", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -69,15 +177,152 @@ func (s *StorageService) List(ctx context.Context, opts *model.ListOptions) ([]*
 
 // Get returns a file in the storage by its identifier.
 // https://developer.crowdin.com/api/v2/#operation/api.storages.get
-func (s *StorageService) Get(ctx context.Context, id int) (*model.Storage, *Response, error) {
+func (s *StorageService) Get(ctx context.Context, id int, reqOpts ...RequestOption) (*model.Storage, *Response, error) {
+	ctx = withOperation(ctx, "StorageService.Get")
 	res := new(model.StorageGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/storages/%d", id), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/storages/%d", id), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
 // Delete deletes a file from the storage by its identifier.
 // https://developer.crowdin.com/api/v2/#operation/api.storages.delete
-func (s *StorageService) Delete(ctx context.Context, id int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/storages/%d", id), nil)
+func (s *StorageService) Delete(ctx context.Context, id int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "StorageService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/storages/%d", id), nil, reqOpts...)
+}
+
+func isZipType(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "application/zip" || mediaType == "application/x-zip-compressed"
+}
+
+// contentSize returns the number of bytes remaining in the reader,
+// or -1 if it is unknown.
+func contentSize(r io.Reader) int64 {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len())
+	case io.Seeker:
+		cur, err := r.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		end, err := r.Seek(0, io.SeekEnd)
+		if err != nil {
+			return -1
+		}
+		if _, err := r.Seek(cur, io.SeekStart); err != nil {
+			return -1
+		}
+		return end - cur
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := r.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return -1
+		}
+		return info.Size()
+	default:
+		return -1
+	}
+}
+
+// contentLength sets the length of the request body.
+func contentLength(n int64) RequestOption {
+	return func(r *http.Request) error {
+		r.ContentLength = n
+		if n == 0 {
+			r.Body, r.GetBody = http.NoBody, nil
+		}
+		return nil
+	}
+}
+
+// progressReader reports the number of bytes read.
+type progressReader struct {
+	r        io.Reader
+	start    int64
+	read     int64
+	total    int64
+	progress func(written, total int64)
+}
+
+// progressReadSeeker is a progressReader of an io.ReadSeeker,
+// so the body of the upload request can be rewound.
+type progressReadSeeker struct {
+	*progressReader
+}
+
+func newProgressReader(r io.Reader, total int64, progress func(written, total int64)) io.Reader {
+	pr := &progressReader{r: r, total: total, progress: progress}
+	if rs, ok := r.(io.ReadSeeker); ok {
+		pr.start, _ = rs.Seek(0, io.SeekCurrent)
+		return progressReadSeeker{pr}
+	}
+	return pr
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.read += int64(n)
+		r.progress(r.read, r.total)
+	}
+	return n, err
+}
+
+func (r progressReadSeeker) Seek(offset int64, whence int) (int64, error) {
+	pos, err := r.r.(io.Seeker).Seek(offset, whence) //nolint:forcetypeassert // checked by newProgressReader
+	if err == nil {
+		r.read = pos - r.start
+	}
+	return pos, err
+}
+
+// withStorage uploads the content to the storage, calls fn with the
+// identifier of the storage and deletes the storage afterwards, whether
+// fn succeeds or not. The storage is deleted even if the context is
+// canceled; errors deleting it are ignored as storages expire anyway.
+// The consumers must be done with the storage when fn returns, so
+// asynchronous imports use withImportStorage.
+func withStorage[T any](ctx context.Context, s *StorageService, name string, r io.Reader, reqOpts []RequestOption,
+	fn func(storageID int) (T, *Response, error),
+) (T, *Response, error) {
+	storage, resp, err := s.AddReader(ctx, name, r, nil, reqOpts...)
+	if err != nil {
+		var zero T
+		return zero, resp, err
+	}
+	defer func() {
+		_, _ = s.Delete(context.WithoutCancel(ctx), storage.ID, reqOpts...)
+	}()
+
+	return fn(storage.ID)
+}
+
+// withImportStorage uploads the content to the storage, starts an
+// asynchronous import of the storage with start and waits for the import
+// to end with the operation. The storage is deleted once the import has
+// ended, or if the server rejected it. If waiting is interrupted, e.g.
+// the context is canceled, the import may still be reading the storage,
+// so it is kept until it expires.
+func withImportStorage[T any](ctx context.Context, s *StorageService, name string, r io.Reader, reqOpts []RequestOption,
+	start func(storageID int) (*T, *Response, error), operation func(*T) *Operation[T],
+) (*T, *Response, error) {
+	storage, resp, err := s.AddReader(ctx, name, r, nil, reqOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	res, resp, err := start(storage.ID)
+	ended := err != nil && resp != nil && resp.Response != nil
+	if err == nil {
+		res, err = operation(res).Wait(ctx)
+		var opErr *OperationError
+		ended = err == nil || errors.As(err, &opErr)
+	}
+	if ended {
+		_, _ = s.Delete(context.WithoutCancel(ctx), storage.ID, reqOpts...)
+	}
+	return res, resp, err
 }
@@ -1,13 +1,20 @@
 package crowdin
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
 	"github.com/stretchr/testify/assert"
@@ -194,3 +201,273 @@ func openFile(name, content string) (*os.File, string, error) {
 
 	return file, dir, nil
 }
+
+func handleStorageUpload(t *testing.T, mux *http.ServeMux, fn func(r *http.Request, body []byte)) {
+	t.Helper()
+
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		fn(r, body)
+		fmt.Fprint(w, `{"data": {"id": 1, "fileName": "upload.json"}}`)
+	})
+}
+
+func TestStorageService_AddReader(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleStorageUpload(t, mux, func(r *http.Request, body []byte) {
+		testHeader(t, r, "Content-Type", "application/json")
+		testHeader(t, r, "Crowdin-API-FileName", "upload+file.json")
+		assert.Equal(t, int64(13), r.ContentLength)
+		assert.Empty(t, r.TransferEncoding)
+		assert.Equal(t, `{"key":"val"}`, string(body))
+	})
+
+	var progress []int64
+	storage, _, err := client.Storages.AddReader(context.Background(), "upload file.json", strings.NewReader(`{"key":"val"}`), &StorageOptions{
+		Progress: func(written, total int64) {
+			assert.Equal(t, int64(13), total)
+			progress = append(progress, written)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &model.Storage{ID: 1, FileName: "upload.json"}, storage)
+	assert.Equal(t, int64(13), progress[len(progress)-1])
+}
+
+func TestStorageService_AddReader_unknownSize(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleStorageUpload(t, mux, func(r *http.Request, body []byte) {
+		testHeader(t, r, "Content-Type", "text/csv")
+		assert.Equal(t, []string{"chunked"}, r.TransferEncoding)
+		assert.Equal(t, "a,b\n1,2\n", string(body))
+	})
+
+	pr, pw := io.Pipe()
+	go func() {
+		fmt.Fprint(pw, "a,b\n1,2\n")
+		pw.Close()
+	}()
+
+	_, _, err := client.Storages.AddReader(context.Background(), "data", pr, &StorageOptions{ContentType: "text/csv"})
+	require.NoError(t, err)
+}
+
+func TestStorageService_AddReader_size(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleStorageUpload(t, mux, func(r *http.Request, body []byte) {
+		assert.Equal(t, int64(4), r.ContentLength)
+		assert.Equal(t, "data", string(body))
+	})
+
+	_, _, err := client.Storages.AddReader(context.Background(), "data.txt", io.LimitReader(strings.NewReader("data"), 4), &StorageOptions{Size: 4})
+	require.NoError(t, err)
+}
+
+func TestStorageService_AddReader_retry(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+	require.NoError(t, WithRetryPolicy(RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})(client))
+
+	var calls int
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(body))
+
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"data": {"id": 1}}`)
+	})
+
+	var progress []int64
+	_, _, err := client.Storages.AddReader(context.Background(), "file.txt", bytes.NewReader([]byte("content")), &StorageOptions{
+		Progress: func(written, _ int64) { progress = append(progress, written) },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []int64{7, 7}, progress)
+}
+
+func TestStorageService_AddReader_zip(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages", func(http.ResponseWriter, *http.Request) {
+		t.Error("ZIP archive is uploaded")
+	})
+
+	tests := []struct {
+		name string
+		r    io.Reader
+		opts *StorageOptions
+	}{
+		{"archive.zip", strings.NewReader("content"), nil},
+		{"archive", strings.NewReader("content"), &StorageOptions{ContentType: "application/zip"}},
+		{"ARCHIVE.ZIP", strings.NewReader("content"), nil},
+	}
+
+	for _, tt := range tests {
+		_, _, err := client.Storages.AddReader(context.Background(), tt.name, tt.r, tt.opts)
+		require.ErrorIs(t, err, ErrZipUpload, tt.name)
+	}
+}
+
+func TestStorageService_Add_zipContainer(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	const content = "PK\x03\x04[Content_Types].xml"
+	handleStorageUpload(t, mux, func(r *http.Request, body []byte) {
+		testHeader(t, r, "Crowdin-API-FileName", "report.docx")
+		assert.Equal(t, content, string(body))
+	})
+
+	name := filepath.Join(t.TempDir(), "report.docx")
+	require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	file, err := os.Open(name)
+	require.NoError(t, err)
+	defer file.Close()
+
+	storage, _, err := client.Storages.Add(context.Background(), file)
+	require.NoError(t, err)
+	assert.Equal(t, 1, storage.ID)
+}
+
+func TestStorageService_AddReader_invalid(t *testing.T) {
+	client, _, teardown := setupClient()
+	defer teardown()
+
+	_, _, err := client.Storages.AddReader(context.Background(), "", strings.NewReader(""), nil)
+	require.EqualError(t, err, "name is required")
+
+	_, _, err = client.Storages.AddReader(context.Background(), "file.txt", nil, nil)
+	require.EqualError(t, err, "reader is required")
+}
+
+func TestStorageService_AddFS(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleStorageUpload(t, mux, func(r *http.Request, body []byte) {
+		testHeader(t, r, "Crowdin-API-FileName", "strings.xml")
+		testHeader(t, r, "Content-Type", "text/xml; charset=utf-8")
+		assert.Equal(t, int64(11), r.ContentLength)
+		assert.Equal(t, "<resources>", string(body))
+	})
+
+	fsys := fstest.MapFS{
+		"res/values/strings.xml": {Data: []byte("<resources>")},
+	}
+
+	_, _, err := client.Storages.AddFS(context.Background(), fsys, "res/values/strings.xml", nil)
+	require.NoError(t, err)
+
+	_, _, err = client.Storages.AddFS(context.Background(), fsys, "res/values", nil)
+	require.EqualError(t, err, "res/values is not a regular file")
+
+	_, _, err = client.Storages.AddFS(context.Background(), fsys, "missing.xml", nil)
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+// handleStorageLifecycle serves the storage upload and records the
+// identifiers of the deleted storages.
+func handleStorageLifecycle(t *testing.T, mux *http.ServeMux, content string) *[]string {
+	t.Helper()
+
+	var deleted []string
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(body))
+		fmt.Fprint(w, `{"data": {"id": 61, "fileName": "upload"}}`)
+	})
+	mux.HandleFunc("/api/v2/storages/61", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = append(deleted, "61")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return &deleted
+}
+
+func TestWithStorage_uploadError(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	mux.HandleFunc("/api/v2/storages", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, _, err := withStorage(context.Background(), client.Storages, "file.txt", strings.NewReader("content"), nil,
+		func(int) (any, *Response, error) {
+			t.Error("Consumer is called after a failed upload")
+			return nil, nil, nil
+		})
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestWithStorage_canceledContext(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "content")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, _, err := withStorage(ctx, client.Storages, "file.txt", strings.NewReader("content"), nil,
+		func(int) (any, *Response, error) {
+			cancel()
+			return nil, nil, ctx.Err()
+		})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"61"}, *deleted)
+}
+
+func TestWithImportStorage_interrupted(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "content")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mux.HandleFunc("/api/v2/projects/1/translations/builds/2", func(w http.ResponseWriter, _ *http.Request) {
+		cancel()
+		fmt.Fprint(w, `{"data": {"id": 2, "status": "inProgress"}}`)
+	})
+
+	_, _, err := withImportStorage(ctx, client.Storages, "file.txt", strings.NewReader("content"), nil,
+		func(storageID int) (*model.TranslationsProjectBuild, *Response, error) {
+			assert.Equal(t, 61, storageID)
+			return &model.TranslationsProjectBuild{ID: 2, Status: "created"}, nil, nil
+		},
+		func(*model.TranslationsProjectBuild) *Operation[model.TranslationsProjectBuild] {
+			return client.Translations.BuildOperation(1, 2)
+		})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, *deleted, "storage is deleted while the import may still read it")
+}
+
+func TestWithImportStorage_rejected(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "content")
+	mux.HandleFunc("/api/v2/tms/4/imports", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error": {"code": 404, "message": "TM Not Found"}}`, http.StatusNotFound)
+	})
+
+	_, _, err := client.TranslationMemory.ImportTMFromReader(context.Background(), 4, "memory.tmx", strings.NewReader("content"), nil)
+	require.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, []string{"61"}, *deleted)
+}
@@ -17,11 +17,12 @@ type StringCommentsService struct {
 // List returns a list of string comments.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.comments.getMany
-func (s *StringCommentsService) List(ctx context.Context, projectID int, opts *model.StringCommentsListOptions) (
+func (s *StringCommentsService) List(ctx context.Context, projectID int, opts *model.StringCommentsListOptions, reqOpts ...RequestOption) (
 	[]*model.StringComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringCommentsService.List")
 	res := new(model.StringCommentsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/comments", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/comments", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -43,11 +44,12 @@ func (s *StringCommentsService) List(ctx context.Context, projectID int, opts *m
 //     The value must be string.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/String-Comments/operation/api.projects.comments.batchPatch
-func (s *StringCommentsService) BatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest) (
+func (s *StringCommentsService) BatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	[]*model.StringComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringCommentsService.BatchOperations")
 	res := new(model.StringCommentsListResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/comments", projectID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/comments", projectID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -63,11 +65,12 @@ func (s *StringCommentsService) BatchOperations(ctx context.Context, projectID i
 // Get returns a string comment by its ID.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.comments.post
-func (s *StringCommentsService) Get(ctx context.Context, projectID, commentID int) (
+func (s *StringCommentsService) Get(ctx context.Context, projectID, commentID int, reqOpts ...RequestOption) (
 	*model.StringComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringCommentsService.Get")
 	res := new(model.StringCommentsResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -75,11 +78,12 @@ func (s *StringCommentsService) Get(ctx context.Context, projectID, commentID in
 // Add creates a new string comment.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.comments.post
-func (s *StringCommentsService) Add(ctx context.Context, projectID int, req *model.StringCommentsAddRequest) (
+func (s *StringCommentsService) Add(ctx context.Context, projectID int, req *model.StringCommentsAddRequest, reqOpts ...RequestOption) (
 	*model.StringComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringCommentsService.Add")
 	res := new(model.StringCommentsResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/comments", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/comments", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -93,11 +97,12 @@ func (s *StringCommentsService) Add(ctx context.Context, projectID int, req *mod
 //     The value must be string.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.comments.patch
-func (s *StringCommentsService) Edit(ctx context.Context, projectID, commentID int, req []*model.UpdateRequest) (
+func (s *StringCommentsService) Edit(ctx context.Context, projectID, commentID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.StringComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringCommentsService.Edit")
 	res := new(model.StringCommentsResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -105,6 +110,7 @@ func (s *StringCommentsService) Edit(ctx context.Context, projectID, commentID i
 // Delete removes a string comment.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.comments.delete
-func (s *StringCommentsService) Delete(ctx context.Context, projectID, commentID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID), nil)
+func (s *StringCommentsService) Delete(ctx context.Context, projectID, commentID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "StringCommentsService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/comments/%d", projectID, commentID), nil, reqOpts...)
 }
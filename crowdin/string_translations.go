@@ -17,11 +17,12 @@ type StringTranslationsService struct {
 // ListApprovals returns a list of translation approvals.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.approvals.getMany
-func (s *StringTranslationsService) ListApprovals(ctx context.Context, projectID int, opts *model.ApprovalsListOptions) (
+func (s *StringTranslationsService) ListApprovals(ctx context.Context, projectID int, opts *model.ApprovalsListOptions, reqOpts ...RequestOption) (
 	[]*model.Approval, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.ListApprovals")
 	res := new(model.ApprovalsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -37,9 +38,10 @@ func (s *StringTranslationsService) ListApprovals(ctx context.Context, projectID
 // GetApproval returns a single translation approval by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.approvals.get
-func (s *StringTranslationsService) GetApproval(ctx context.Context, projectID, approvalID int) (*model.Approval, *Response, error) {
+func (s *StringTranslationsService) GetApproval(ctx context.Context, projectID, approvalID int, reqOpts ...RequestOption) (*model.Approval, *Response, error) {
+	ctx = withOperation(ctx, "StringTranslationsService.GetApproval")
 	res := new(model.ApprovalsGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals/%d", projectID, approvalID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals/%d", projectID, approvalID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -47,15 +49,16 @@ func (s *StringTranslationsService) GetApproval(ctx context.Context, projectID,
 // AddApproval adds a new translation approval.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.approvals.post
-func (s *StringTranslationsService) AddApproval(ctx context.Context, projectID, translationID int) (
+func (s *StringTranslationsService) AddApproval(ctx context.Context, projectID, translationID int, reqOpts ...RequestOption) (
 	*model.Approval, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.AddApproval")
 	req := struct {
 		TranslationID int `json:"translationId"`
 	}{TranslationID: translationID}
 
 	res := new(model.ApprovalsGetResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -67,11 +70,12 @@ func (s *StringTranslationsService) AddApproval(ctx context.Context, projectID,
 //   - path: A JSON Pointer as defined by RFC 6901. Example: "/{approvalId}".
 //
 // https://support.crowdin.com/developer/api/v2/#tag/String-Translations/operation/api.projects.approvals.patch
-func (s *StringTranslationsService) ApprovalBatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest) (
+func (s *StringTranslationsService) ApprovalBatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	[]*model.Approval, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.ApprovalBatchOperations")
 	res := new(model.ApprovalsListResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals", projectID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals", projectID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -91,11 +95,12 @@ func (s *StringTranslationsService) ApprovalBatchOperations(ctx context.Context,
 //   - path: A JSON Pointer as defined by RFC 6901. Example: "/{translationId}".
 //
 // https://support.crowdin.com/developer/api/v2/#tag/String-Translations/operation/api.projects.translations.patch
-func (s *StringTranslationsService) TranslationBatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest) (
+func (s *StringTranslationsService) TranslationBatchOperations(ctx context.Context, projectID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	[]*model.Translation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.TranslationBatchOperations")
 	res := new(model.TranslationsListResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/translations", projectID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/translations", projectID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -111,25 +116,28 @@ func (s *StringTranslationsService) TranslationBatchOperations(ctx context.Conte
 // RemoveStringApprovals removes translation approvals by its string identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.approvals.deleteMany
-func (s *StringTranslationsService) RemoveStringApprovals(ctx context.Context, projectID, stringID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals?stringId=%d", projectID, stringID), nil)
+func (s *StringTranslationsService) RemoveStringApprovals(ctx context.Context, projectID, stringID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "StringTranslationsService.RemoveStringApprovals")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals?stringId=%d", projectID, stringID), nil, reqOpts...)
 }
 
 // RemoveApproval removes a translation approval by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.approvals.delete
-func (s *StringTranslationsService) RemoveApproval(ctx context.Context, projectID, approvalID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals/%d", projectID, approvalID), nil)
+func (s *StringTranslationsService) RemoveApproval(ctx context.Context, projectID, approvalID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "StringTranslationsService.RemoveApproval")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/approvals/%d", projectID, approvalID), nil, reqOpts...)
 }
 
 // TranslationAlignment aligns translations.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.alignment.post
-func (s *StringTranslationsService) TranslationAlignment(ctx context.Context, projectID int, req *model.TranslationAlignmentRequest) (
+func (s *StringTranslationsService) TranslationAlignment(ctx context.Context, projectID int, req *model.TranslationAlignmentRequest, reqOpts ...RequestOption) (
 	*model.TranslationAlignment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.TranslationAlignment")
 	res := new(model.TranslationAlignmentResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/alignment", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/alignment", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -144,11 +152,12 @@ func (s *StringTranslationsService) ListLanguageTranslations(
 	ctx context.Context,
 	projectID int,
 	languageID string,
-	opts *model.LanguageTranslationsListOptions) (
+	opts *model.LanguageTranslationsListOptions, reqOpts ...RequestOption) (
 	[]*model.LanguageTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.ListLanguageTranslations")
 	res := new(model.LanguageTranslationsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/%s/translations", projectID, languageID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/%s/translations", projectID, languageID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -167,11 +176,12 @@ func (s *StringTranslationsService) ListLanguageTranslations(
 // it is recommended to use OTA.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.getMany
-func (s *StringTranslationsService) ListStringTranslations(ctx context.Context, projectID int, opts *model.StringTranslationsListOptions) (
+func (s *StringTranslationsService) ListStringTranslations(ctx context.Context, projectID int, opts *model.StringTranslationsListOptions, reqOpts ...RequestOption) (
 	[]*model.Translation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.ListStringTranslations")
 	res := new(model.TranslationsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -187,26 +197,28 @@ func (s *StringTranslationsService) ListStringTranslations(ctx context.Context,
 // DeleteStringTranslations deletes string translations by its identifiers.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.deleteMany
-func (s *StringTranslationsService) DeleteStringTranslations(ctx context.Context, projectID, stringID int, languageID *string) (
+func (s *StringTranslationsService) DeleteStringTranslations(ctx context.Context, projectID, stringID int, languageID *string, reqOpts ...RequestOption) (
 	*Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.DeleteStringTranslations")
 	path := fmt.Sprintf("/api/v2/projects/%d/translations?stringId=%d", projectID, stringID)
 
 	if languageID != nil {
 		path += fmt.Sprintf("&languageId=%s", *languageID)
 	}
 
-	return s.client.Delete(ctx, path, nil)
+	return s.client.Delete(ctx, path, nil, reqOpts...)
 }
 
 // GetTranslation returns a single string translation by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.get
-func (s *StringTranslationsService) GetTranslation(ctx context.Context, projectID, translationID int, opts *model.TranslationGetOptions) (
+func (s *StringTranslationsService) GetTranslation(ctx context.Context, projectID, translationID int, opts *model.TranslationGetOptions, reqOpts ...RequestOption) (
 	*model.Translation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.GetTranslation")
 	res := new(model.TranslationGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID), opts, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -214,11 +226,12 @@ func (s *StringTranslationsService) GetTranslation(ctx context.Context, projectI
 // AddTranslation adds a new string translation.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.post
-func (s *StringTranslationsService) AddTranslation(ctx context.Context, projectID int, req *model.TranslationAddRequest) (
+func (s *StringTranslationsService) AddTranslation(ctx context.Context, projectID int, req *model.TranslationAddRequest, reqOpts ...RequestOption) (
 	*model.Translation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.AddTranslation")
 	res := new(model.TranslationGetResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -226,11 +239,12 @@ func (s *StringTranslationsService) AddTranslation(ctx context.Context, projectI
 // RestoreTranslation restores a translation by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.put
-func (s *StringTranslationsService) RestoreTranslation(ctx context.Context, projectID, translationID int) (
+func (s *StringTranslationsService) RestoreTranslation(ctx context.Context, projectID, translationID int, reqOpts ...RequestOption) (
 	*model.Translation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.RestoreTranslation")
 	res := new(model.TranslationGetResponse)
-	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID), nil, res)
+	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -238,8 +252,9 @@ func (s *StringTranslationsService) RestoreTranslation(ctx context.Context, proj
 // DeleteTranslation deletes a translation by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.delete
-func (s *StringTranslationsService) DeleteTranslation(ctx context.Context, projectID, translationID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID), nil)
+func (s *StringTranslationsService) DeleteTranslation(ctx context.Context, projectID, translationID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "StringTranslationsService.DeleteTranslation")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%d", projectID, translationID), nil, reqOpts...)
 }
 
 // ListVotes lists translation votes.
@@ -248,11 +263,12 @@ func (s *StringTranslationsService) DeleteTranslation(ctx context.Context, proje
 // `languageId` OR `stringId` with `languageId` are required
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.votes.getMany
-func (s *StringTranslationsService) ListVotes(ctx context.Context, projectID int, opts *model.VotesListOptions) (
+func (s *StringTranslationsService) ListVotes(ctx context.Context, projectID int, opts *model.VotesListOptions, reqOpts ...RequestOption) (
 	[]*model.Vote, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.ListVotes")
 	res := new(model.VotesListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/votes", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/votes", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -268,9 +284,10 @@ func (s *StringTranslationsService) ListVotes(ctx context.Context, projectID int
 // GetVote gets a single translation vote by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.votes.get
-func (s *StringTranslationsService) GetVote(ctx context.Context, projectID, voteID int) (*model.Vote, *Response, error) {
+func (s *StringTranslationsService) GetVote(ctx context.Context, projectID, voteID int, reqOpts ...RequestOption) (*model.Vote, *Response, error) {
+	ctx = withOperation(ctx, "StringTranslationsService.GetVote")
 	res := new(model.VoteGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/votes/%d", projectID, voteID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/votes/%d", projectID, voteID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -278,11 +295,12 @@ func (s *StringTranslationsService) GetVote(ctx context.Context, projectID, vote
 // AddVote adds a vote for a translation.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.votes.post
-func (s *StringTranslationsService) AddVote(ctx context.Context, projectID int, req *model.VoteAddRequest) (
+func (s *StringTranslationsService) AddVote(ctx context.Context, projectID int, req *model.VoteAddRequest, reqOpts ...RequestOption) (
 	*model.Vote, *Response, error,
 ) {
+	ctx = withOperation(ctx, "StringTranslationsService.AddVote")
 	res := new(model.VoteGetResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/votes", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/votes", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -290,6 +308,7 @@ func (s *StringTranslationsService) AddVote(ctx context.Context, projectID int,
 // CancelVote cancels a vote for a translation by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.votes.delete
-func (s *StringTranslationsService) CancelVote(ctx context.Context, projectID, voteID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/votes/%d", projectID, voteID), nil)
+func (s *StringTranslationsService) CancelVote(ctx context.Context, projectID, voteID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "StringTranslationsService.CancelVote")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/votes/%d", projectID, voteID), nil, reqOpts...)
 }
@@ -22,9 +22,10 @@ type TasksService struct {
 // List returns a list of tasks in a project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.getMany
-func (s *TasksService) List(ctx context.Context, projectID int, opts *model.TasksListOptions) ([]*model.Task, *Response, error) {
+func (s *TasksService) List(ctx context.Context, projectID int, opts *model.TasksListOptions, reqOpts ...RequestOption) ([]*model.Task, *Response, error) {
+	ctx = withOperation(ctx, "TasksService.List")
 	res := new(model.TasksListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -40,9 +41,10 @@ func (s *TasksService) List(ctx context.Context, projectID int, opts *model.Task
 // Get returns a single task in a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.get
-func (s *TasksService) Get(ctx context.Context, projectID, taskID int) (*model.Task, *Response, error) {
+func (s *TasksService) Get(ctx context.Context, projectID, taskID int, reqOpts ...RequestOption) (*model.Task, *Response, error) {
+	ctx = withOperation(ctx, "TasksService.Get")
 	res := new(model.TaskResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -50,9 +52,10 @@ func (s *TasksService) Get(ctx context.Context, projectID, taskID int) (*model.T
 // Add creates a new task in a project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.post
-func (s *TasksService) Add(ctx context.Context, projectID int, req model.TaskAddRequester) (*model.Task, *Response, error) {
+func (s *TasksService) Add(ctx context.Context, projectID int, req model.TaskAddRequester, reqOpts ...RequestOption) (*model.Task, *Response, error) {
+	ctx = withOperation(ctx, "TasksService.Add")
 	res := new(model.TaskResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -85,9 +88,10 @@ func (s *TasksService) Add(ctx context.Context, projectID int, req model.TaskAdd
 //   - value (any): Value to be set. Enum: string, bool, array of integers, array of objects.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.patch
-func (s *TasksService) Edit(ctx context.Context, projectID, taskID int, req []*model.UpdateRequest) (*model.Task, *Response, error) {
+func (s *TasksService) Edit(ctx context.Context, projectID, taskID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.Task, *Response, error) {
+	ctx = withOperation(ctx, "TasksService.Edit")
 	res := new(model.TaskResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -95,16 +99,18 @@ func (s *TasksService) Edit(ctx context.Context, projectID, taskID int, req []*m
 // Delete removes a task from a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.delete
-func (s *TasksService) Delete(ctx context.Context, projectID, taskID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID), nil)
+func (s *TasksService) Delete(ctx context.Context, projectID, taskID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TasksService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d", projectID, taskID), nil, reqOpts...)
 }
 
 // ListUserTasks returns a list of tasks assigned to the user.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.user.tasks.getMany
-func (s *TasksService) ListUserTasks(ctx context.Context, opts *model.UserTasksListOptions) ([]*model.Task, *Response, error) {
+func (s *TasksService) ListUserTasks(ctx context.Context, opts *model.UserTasksListOptions, reqOpts ...RequestOption) ([]*model.Task, *Response, error) {
+	ctx = withOperation(ctx, "TasksService.ListUserTasks")
 	res := new(model.TasksListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/user/tasks", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/user/tasks", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -126,11 +132,12 @@ func (s *TasksService) ListUserTasks(ctx context.Context, opts *model.UserTasksL
 // to a list of all tasks
 //
 // https://developer.crowdin.com/api/v2/#operation/api.user.tasks.patch
-func (s *TasksService) EditArchivedStatus(ctx context.Context, projectID, taskID int, req []*model.UpdateRequest) (
+func (s *TasksService) EditArchivedStatus(ctx context.Context, projectID, taskID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Task, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.EditArchivedStatus")
 	res := new(model.TaskResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tasks/%d?projectId=%d", taskID, projectID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tasks/%d?projectId=%d", taskID, projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -138,9 +145,10 @@ func (s *TasksService) EditArchivedStatus(ctx context.Context, projectID, taskID
 // ExportStrings returns a download link.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tasks.exports.post
-func (s *TasksService) ExportStrings(ctx context.Context, projectID, taskID int) (*model.DownloadLink, *Response, error) {
+func (s *TasksService) ExportStrings(ctx context.Context, projectID, taskID int, reqOpts ...RequestOption) (*model.DownloadLink, *Response, error) {
+	ctx = withOperation(ctx, "TasksService.ExportStrings")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/exports", projectID, taskID), "", res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/exports", projectID, taskID), "", res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -148,11 +156,12 @@ func (s *TasksService) ExportStrings(ctx context.Context, projectID, taskID int)
 // ListSettingsTemplates returns a list of task settings templates in a project.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.tasks.settings-templates.getMany
-func (s *TasksService) ListSettingsTemplates(ctx context.Context, projectID int, opts *model.ListOptions) (
+func (s *TasksService) ListSettingsTemplates(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.TaskSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.ListSettingsTemplates")
 	res := new(model.TaskSettingsTemplatesListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -168,12 +177,13 @@ func (s *TasksService) ListSettingsTemplates(ctx context.Context, projectID int,
 // GetSettingsTemplate returns a single task settings template in a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.tasks.settings-templates.get
-func (s *TasksService) GetSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int) (
+func (s *TasksService) GetSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int, reqOpts ...RequestOption) (
 	*model.TaskSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.GetSettingsTemplate")
 	path := fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates/%d", projectID, taskSettingTemplateID)
 	res := new(model.TaskSettingsTemplateResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -181,11 +191,12 @@ func (s *TasksService) GetSettingsTemplate(ctx context.Context, projectID, taskS
 // AddSettingsTemplate creates a new task settings template in a project.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.tasks.settings-templates.post
-func (s *TasksService) AddSettingsTemplate(ctx context.Context, projectID int, req *model.TaskSettingsTemplateAddRequest) (
+func (s *TasksService) AddSettingsTemplate(ctx context.Context, projectID int, req *model.TaskSettingsTemplateAddRequest, reqOpts ...RequestOption) (
 	*model.TaskSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.AddSettingsTemplate")
 	res := new(model.TaskSettingsTemplateResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -198,12 +209,13 @@ func (s *TasksService) AddSettingsTemplate(ctx context.Context, projectID int, r
 // - value (string|int): Value to be set. Enum: string, integer.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.tasks.settings-templates.patch
-func (s *TasksService) EditSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int, req []*model.UpdateRequest) (
+func (s *TasksService) EditSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.TaskSettingsTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.EditSettingsTemplate")
 	path := fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates/%d", projectID, taskSettingTemplateID)
 	res := new(model.TaskSettingsTemplateResponse)
-	resp, err := s.client.Patch(ctx, path, req, res)
+	resp, err := s.client.Patch(ctx, path, req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -211,18 +223,20 @@ func (s *TasksService) EditSettingsTemplate(ctx context.Context, projectID, task
 // DeleteSettingsTemplate removes a task settings template from a project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/string-based/#operation/api.projects.tasks.settings-templates.delete
-func (s *TasksService) DeleteSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates/%d", projectID, taskSettingTemplateID), nil)
+func (s *TasksService) DeleteSettingsTemplate(ctx context.Context, projectID, taskSettingTemplateID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TasksService.DeleteSettingsTemplate")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/settings-templates/%d", projectID, taskSettingTemplateID), nil, reqOpts...)
 }
 
 // ListComments returns a list of task comments in a project.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Tasks/operation/api.projects.tasks.comments.getMany
-func (s *TasksService) ListComments(ctx context.Context, projectID, taskID int, opts *model.ListOptions) (
+func (s *TasksService) ListComments(ctx context.Context, projectID, taskID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.TaskComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.ListComments")
 	res := new(model.TaskCommentsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments", projectID, taskID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments", projectID, taskID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -238,12 +252,13 @@ func (s *TasksService) ListComments(ctx context.Context, projectID, taskID int,
 // GetComment returns a single task comment in a project by its identifier.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Tasks/operation/api.projects.tasks.comments.get
-func (s *TasksService) GetComment(ctx context.Context, projectID, taskID, commentID int) (
+func (s *TasksService) GetComment(ctx context.Context, projectID, taskID, commentID int, reqOpts ...RequestOption) (
 	*model.TaskComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.GetComment")
 	path := fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments/%d", projectID, taskID, commentID)
 	res := new(model.TaskCommentResponse)
-	resp, err := s.client.Get(ctx, path, nil, res)
+	resp, err := s.client.Get(ctx, path, nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -251,11 +266,12 @@ func (s *TasksService) GetComment(ctx context.Context, projectID, taskID, commen
 // AddComment creates a new comment for a specific task in a project.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Tasks/operation/api.projects.tasks.comments.post
-func (s *TasksService) AddComment(ctx context.Context, projectID, taskID int, req *model.TaskCommentAddRequest) (
+func (s *TasksService) AddComment(ctx context.Context, projectID, taskID int, req *model.TaskCommentAddRequest, reqOpts ...RequestOption) (
 	*model.TaskComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.AddComment")
 	res := new(model.TaskCommentResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments", projectID, taskID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments", projectID, taskID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -263,11 +279,12 @@ func (s *TasksService) AddComment(ctx context.Context, projectID, taskID int, re
 // EditComment updates a comment for a specific task in a project by its identifier.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Tasks/operation/api.projects.tasks.comments.patch
-func (s *TasksService) EditComment(ctx context.Context, projectID, taskID, commentID int, req []*model.UpdateRequest) (
+func (s *TasksService) EditComment(ctx context.Context, projectID, taskID, commentID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.TaskComment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TasksService.EditComment")
 	res := new(model.TaskCommentResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments/%d", projectID, taskID, commentID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments/%d", projectID, taskID, commentID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -275,6 +292,7 @@ func (s *TasksService) EditComment(ctx context.Context, projectID, taskID, comme
 // DeleteComment removes a comment from a specific task in a project by its identifier.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Tasks/operation/api.projects.tasks.comments.delete
-func (s *TasksService) DeleteComment(ctx context.Context, projectID, taskID, commentID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments/%d", projectID, taskID, commentID), nil)
+func (s *TasksService) DeleteComment(ctx context.Context, projectID, taskID, commentID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TasksService.DeleteComment")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/tasks/%d/comments/%d", projectID, taskID, commentID), nil, reqOpts...)
 }
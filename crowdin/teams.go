@@ -17,9 +17,10 @@ type TeamsService struct {
 // List returns a list of teams in the organization.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.getMany
-func (s *TeamsService) List(ctx context.Context, opts *model.TeamsListOptions) ([]*model.Team, *Response, error) {
+func (s *TeamsService) List(ctx context.Context, opts *model.TeamsListOptions, reqOpts ...RequestOption) ([]*model.Team, *Response, error) {
+	ctx = withOperation(ctx, "TeamsService.List")
 	res := new(model.TeamsListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/teams", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/teams", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -35,9 +36,10 @@ func (s *TeamsService) List(ctx context.Context, opts *model.TeamsListOptions) (
 // Get returns a team by its identifier.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.get
-func (s *TeamsService) Get(ctx context.Context, teamID int) (*model.Team, *Response, error) {
+func (s *TeamsService) Get(ctx context.Context, teamID int, reqOpts ...RequestOption) (*model.Team, *Response, error) {
+	ctx = withOperation(ctx, "TeamsService.Get")
 	res := new(model.TeamResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/teams/%d", teamID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/teams/%d", teamID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -45,9 +47,10 @@ func (s *TeamsService) Get(ctx context.Context, teamID int) (*model.Team, *Respo
 // Add creates a new team.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.post
-func (s *TeamsService) Add(ctx context.Context, req *model.TeamAddRequest) (*model.Team, *Response, error) {
+func (s *TeamsService) Add(ctx context.Context, req *model.TeamAddRequest, reqOpts ...RequestOption) (*model.Team, *Response, error) {
+	ctx = withOperation(ctx, "TeamsService.Add")
 	res := new(model.TeamResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/teams", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/teams", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -55,9 +58,10 @@ func (s *TeamsService) Add(ctx context.Context, req *model.TeamAddRequest) (*mod
 // Edit updates a team.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.patch
-func (s *TeamsService) Edit(ctx context.Context, teamID int, req []*model.UpdateRequest) (*model.Team, *Response, error) {
+func (s *TeamsService) Edit(ctx context.Context, teamID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.Team, *Response, error) {
+	ctx = withOperation(ctx, "TeamsService.Edit")
 	res := new(model.TeamResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/teams/%d", teamID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/teams/%d", teamID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -65,18 +69,20 @@ func (s *TeamsService) Edit(ctx context.Context, teamID int, req []*model.Update
 // Delete removes a team.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.delete
-func (s *TeamsService) Delete(ctx context.Context, teamID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/teams/%d", teamID), nil)
+func (s *TeamsService) Delete(ctx context.Context, teamID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TeamsService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/teams/%d", teamID), nil, reqOpts...)
 }
 
 // ListMembers returns a list of team members.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.members.getMany
-func (s *TeamsService) ListMembers(ctx context.Context, teamID int, opts *model.ListOptions) (
+func (s *TeamsService) ListMembers(ctx context.Context, teamID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.TeamMember, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TeamsService.ListMembers")
 	res := new(model.TeamMembersListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/teams/%d/members", teamID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/teams/%d/members", teamID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -92,11 +98,12 @@ func (s *TeamsService) ListMembers(ctx context.Context, teamID int, opts *model.
 // AddMember adds a new member to the team.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.members.post
-func (s *TeamsService) AddMember(ctx context.Context, teamID int, req *model.TeamMemberAddRequest) (
+func (s *TeamsService) AddMember(ctx context.Context, teamID int, req *model.TeamMemberAddRequest, reqOpts ...RequestOption) (
 	map[string][]*model.TeamMember, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TeamsService.AddMember")
 	res := new(model.TeamMemberAddResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/teams/%d/members", teamID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/teams/%d/members", teamID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -120,25 +127,28 @@ func (s *TeamsService) AddMember(ctx context.Context, teamID int, req *model.Tea
 // DeleteMember removes a member from the team.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.members.delete
-func (s *TeamsService) DeleteMember(ctx context.Context, teamID, memberID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/teams/%d/members/%d", teamID, memberID), nil)
+func (s *TeamsService) DeleteMember(ctx context.Context, teamID, memberID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TeamsService.DeleteMember")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/teams/%d/members/%d", teamID, memberID), nil, reqOpts...)
 }
 
 // DeleteMembers deletes all members from the team.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.teams.members.deleteMany
-func (s *TeamsService) DeleteMembers(ctx context.Context, teamID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/teams/%d/members", teamID), nil)
+func (s *TeamsService) DeleteMembers(ctx context.Context, teamID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TeamsService.DeleteMembers")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/teams/%d/members", teamID), nil, reqOpts...)
 }
 
 // AddToProject adds a team to the project.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.teams.post
-func (s *TeamsService) AddToProject(ctx context.Context, projectID int, req *model.ProjectTeamAddRequest) (
+func (s *TeamsService) AddToProject(ctx context.Context, projectID int, req *model.ProjectTeamAddRequest, reqOpts ...RequestOption) (
 	map[string]*model.ProjectTeam, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TeamsService.AddToProject")
 	res := new(model.ProjectTeamAddResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/teams", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/teams", projectID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -152,11 +162,12 @@ func (s *TeamsService) AddToProject(ctx context.Context, projectID int, req *mod
 // ListGroupTeams returns a list of groups teams.
 //
 // https://support.crowdin.com/developer/enterprise/api/v2/#tag/Teams/operation/api.groups.teams.getMany
-func (s *TeamsService) ListGroupTeams(ctx context.Context, groupID int, opts *model.TeamsListOptions) ([]*model.GroupsTeam, *Response, error) {
+func (s *TeamsService) ListGroupTeams(ctx context.Context, groupID int, opts *model.TeamsListOptions, reqOpts ...RequestOption) ([]*model.GroupsTeam, *Response, error) {
+	ctx = withOperation(ctx, "TeamsService.ListGroupTeams")
 	res := new(model.GroupsTeamsData)
 	url := fmt.Sprintf("/api/v2/groups/%d/teams", groupID)
 
-	resp, err := s.client.Get(ctx, url, opts, res)
+	resp, err := s.client.Get(ctx, url, opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -172,9 +183,10 @@ func (s *TeamsService) ListGroupTeams(ctx context.Context, groupID int, opts *mo
 // GetGroupTeam returns a group of team by its identifier.
 //
 // https://support.crowdin.com/developer/enterprise/api/v2/#tag/Teams/operation/api.groups.teams.get
-func (s *TeamsService) GetGroupTeam(ctx context.Context, groupID, teamID int) (*model.GroupsTeam, *Response, error) {
+func (s *TeamsService) GetGroupTeam(ctx context.Context, groupID, teamID int, reqOpts ...RequestOption) (*model.GroupsTeam, *Response, error) {
+	ctx = withOperation(ctx, "TeamsService.GetGroupTeam")
 	res := new(model.TeamsGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/teams/%d", groupID, teamID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/teams/%d", groupID, teamID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -182,10 +194,11 @@ func (s *TeamsService) GetGroupTeam(ctx context.Context, groupID, teamID int) (*
 //	EditGroupTeams edit groups of teams.
 //
 // https://support.crowdin.com/developer/enterprise/api/v2/#tag/Teams/operation/api.groups.teams.patch
-func (s *TeamsService) EditGroupTeams(ctx context.Context, groupID int, req []*model.UpdateRequest) ([]*model.GroupsTeam, *Response, error) {
+func (s *TeamsService) EditGroupTeams(ctx context.Context, groupID int, req []*model.UpdateRequest, reqOpts ...RequestOption) ([]*model.GroupsTeam, *Response, error) {
+	ctx = withOperation(ctx, "TeamsService.EditGroupTeams")
 	res := new(model.GroupsTeamsDataEdit)
 	url := fmt.Sprintf("/api/v2/groups/%d/teams", groupID)
-	resp, err := s.client.Patch(ctx, url, req, res)
+	resp, err := s.client.Patch(ctx, url, req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -0,0 +1,192 @@
+package crowdin
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// expiryDelta is how much earlier a token is considered
+// expired than its actual expiration time.
+const expiryDelta = 10 * time.Second
+
+// Token represents the credentials used to authorize the requests.
+// It follows the semantics of golang.org/x/oauth2.Token.
+type Token struct {
+	// AccessToken is the token that authorizes the requests.
+	AccessToken string `json:"access_token"`
+	// TokenType is the type of token. Defaults to "Bearer".
+	TokenType string `json:"token_type,omitempty"`
+	// RefreshToken is used to obtain a new access token
+	// when the current one expires.
+	RefreshToken string `json:"refresh_token,omitempty"`
+	// Expiry is the expiration time of the access token.
+	// Zero value means the token never expires.
+	Expiry time.Time `json:"expiry"`
+}
+
+// Type returns the token type. Defaults to "Bearer".
+func (t *Token) Type() string {
+	if t.TokenType == "" || strings.EqualFold(t.TokenType, "bearer") {
+		return "Bearer"
+	}
+	return t.TokenType
+}
+
+// Valid reports whether the token is non-nil, has an access
+// token and is not expired.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && !t.expired()
+}
+
+func (t *Token) expired() bool {
+	if t.Expiry.IsZero() {
+		return false
+	}
+	return t.Expiry.Round(0).Add(-expiryDelta).Before(time.Now())
+}
+
+// authorization returns the value of the Authorization header.
+func (t *Token) authorization() string {
+	return t.Type() + " " + t.AccessToken
+}
+
+// TokenSource is anything that can return a token. It has the same
+// semantics as golang.org/x/oauth2.TokenSource: the returned token
+// must not be modified and the source must be safe for concurrent use.
+type TokenSource interface {
+	Token() (*Token, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of ordinary
+// functions as token sources.
+type TokenSourceFunc func() (*Token, error)
+
+// Token calls f().
+func (f TokenSourceFunc) Token() (*Token, error) {
+	return f()
+}
+
+// StaticTokenSource returns a TokenSource that always
+// returns the same token.
+func StaticTokenSource(t *Token) TokenSource {
+	return staticTokenSource{t}
+}
+
+type staticTokenSource struct {
+	t *Token
+}
+
+func (s staticTokenSource) Token() (*Token, error) {
+	return s.t, nil
+}
+
+// WithTokenSource sets the source of tokens used to authorize the requests.
+// The token is obtained for every request and reused until it expires.
+// If a request fails with 401 Unauthorized, a new token is obtained from
+// the source and the request is retried once.
+// If set, the token passed to NewClient may be empty.
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *Client) error {
+		if ts == nil {
+			return errors.New("token source cannot be nil")
+		}
+		c.tokenSource = newReuseTokenSource(ts)
+		return nil
+	}
+}
+
+// reuseTokenSource caches the token of the underlying
+// source until it expires or is invalidated.
+type reuseTokenSource struct {
+	mu  sync.Mutex
+	new TokenSource
+	t   *Token
+}
+
+func newReuseTokenSource(ts TokenSource) *reuseTokenSource {
+	return &reuseTokenSource{new: ts}
+}
+
+// Token returns the cached token if it is still valid,
+// otherwise obtains a new one from the underlying source.
+func (s *reuseTokenSource) Token() (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.t.Valid() {
+		return s.t, nil
+	}
+	t, err := s.new.Token()
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.AccessToken == "" {
+		return nil, errors.New("token source returned an empty token")
+	}
+	s.t = t
+	return t, nil
+}
+
+// refresh drops the cached token if it was used for the rejected
+// authorization and returns a new token.
+func (s *reuseTokenSource) refresh(rejected string) (*Token, error) {
+	s.mu.Lock()
+	if s.t != nil && s.t.authorization() == rejected {
+		if inv, ok := s.new.(tokenInvalidator); ok {
+			inv.invalidate(s.t)
+		}
+		s.t = nil
+	}
+	s.mu.Unlock()
+
+	return s.Token()
+}
+
+// tokenInvalidator is implemented by token sources that cache
+// tokens and can be forced to obtain a new one.
+type tokenInvalidator interface {
+	invalidate(rejected *Token)
+}
+
+// refreshable reports whether the token can change over time.
+func (s *reuseTokenSource) refreshable() bool {
+	_, static := s.new.(staticTokenSource)
+	return !static
+}
+
+// authorize sets the Authorization header of the request.
+func (c *Client) authorize(r *http.Request) error {
+	t, err := c.tokenSource.Token()
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Authorization", t.authorization())
+	return nil
+}
+
+// reauthorize replaces the rejected token of the request with a new one
+// obtained from the token source. It reports whether the request can be
+// sent again.
+func (c *Client) reauthorize(r *http.Request) bool {
+	if !c.tokenSource.refreshable() {
+		return false
+	}
+	if overridden, _ := r.Context().Value(accessTokenKey{}).(bool); overridden {
+		return false
+	}
+
+	rejected := r.Header.Get("Authorization")
+	t, err := c.tokenSource.refresh(rejected)
+	if err != nil || t.authorization() == rejected {
+		return false
+	}
+	if !rewindBody(r) {
+		return false
+	}
+
+	r.Header.Set("Authorization", t.authorization())
+	return true
+}
@@ -0,0 +1,157 @@
+package crowdin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingTokenSource returns a new token on every call.
+type countingTokenSource struct {
+	calls  int32
+	expiry time.Duration
+}
+
+func (s *countingTokenSource) Token() (*Token, error) {
+	n := atomic.AddInt32(&s.calls, 1)
+	t := &Token{AccessToken: fmt.Sprintf("token-%d", n)}
+	if s.expiry != 0 {
+		t.Expiry = time.Now().Add(s.expiry)
+	}
+	return t, nil
+}
+
+func TestWithTokenSource(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	ts := &countingTokenSource{}
+	require.NoError(t, WithTokenSource(ts)(client))
+
+	mux.HandleFunc("/get", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer token-1")
+		fmt.Fprint(w, `{}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		_, err := client.Get(context.Background(), "/get", nil, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 1, atomic.LoadInt32(&ts.calls))
+}
+
+func TestWithTokenSource_expiredTokenReplaced(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	// Tokens expiring within expiryDelta are considered expired.
+	ts := &countingTokenSource{expiry: time.Second}
+	require.NoError(t, WithTokenSource(ts)(client))
+
+	var got []string
+	mux.HandleFunc("/get", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Get(context.Background(), "/get", nil, nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, got)
+}
+
+func TestWithTokenSource_refreshOnUnauthorized(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	ts := &countingTokenSource{}
+	require.NoError(t, WithTokenSource(ts)(client))
+
+	var calls int32
+	mux.HandleFunc("/post", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		testJSONBody(t, r, `{"foo":"bar"}`)
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			http.Error(w, `{"error": {"code": 401, "message": "Unauthorized"}}`, http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, err := client.Post(context.Background(), "/post", map[string]string{"foo": "bar"}, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+	assert.EqualValues(t, 2, atomic.LoadInt32(&ts.calls))
+}
+
+func TestWithTokenSource_refreshOnlyOnce(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	ts := &countingTokenSource{}
+	require.NoError(t, WithTokenSource(ts)(client))
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		http.Error(w, `{"error": {"code": 401, "message": "Unauthorized"}}`, http.StatusUnauthorized)
+	})
+
+	resp, err := client.Get(context.Background(), "/get", nil, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestStaticToken_notRefreshed(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/get", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		testHeader(t, r, "Authorization", "Bearer access_token")
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.Get(context.Background(), "/get", nil, nil)
+	require.Error(t, err)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestWithTokenSource_errors(t *testing.T) {
+	_, err := NewClient("", WithTokenSource(nil))
+	require.EqualError(t, err, "token source cannot be nil")
+
+	c, err := NewClient("", WithTokenSource(TokenSourceFunc(func() (*Token, error) {
+		return nil, errors.New("token source failure")
+	})))
+	require.NoError(t, err)
+	_, err = c.Get(context.Background(), "/get", nil, nil)
+	require.EqualError(t, err, "token source failure")
+
+	c, err = NewClient("", WithTokenSource(StaticTokenSource(&Token{})))
+	require.NoError(t, err)
+	_, err = c.Get(context.Background(), "/get", nil, nil)
+	require.EqualError(t, err, "token source returned an empty token")
+}
+
+func TestToken(t *testing.T) {
+	var nilToken *Token
+	assert.False(t, nilToken.Valid())
+	assert.False(t, (&Token{}).Valid())
+	assert.True(t, (&Token{AccessToken: "t"}).Valid())
+	assert.False(t, (&Token{AccessToken: "t", Expiry: time.Now().Add(-time.Minute)}).Valid())
+	assert.True(t, (&Token{AccessToken: "t", Expiry: time.Now().Add(time.Minute)}).Valid())
+
+	assert.Equal(t, "Bearer t", (&Token{AccessToken: "t", TokenType: "bearer"}).authorization())
+	assert.Equal(t, "MAC t", (&Token{AccessToken: "t", TokenType: "MAC"}).authorization())
+}
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
 )
@@ -22,9 +23,10 @@ type TranslationMemoryService struct {
 // GetTM returns a specific translation memory by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.get
-func (s *TranslationMemoryService) GetTM(ctx context.Context, tmID int) (*model.TranslationMemory, *Response, error) {
+func (s *TranslationMemoryService) GetTM(ctx context.Context, tmID int, reqOpts ...RequestOption) (*model.TranslationMemory, *Response, error) {
+	ctx = withOperation(ctx, "TranslationMemoryService.GetTM")
 	res := new(model.TranslationMemoryResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -32,11 +34,12 @@ func (s *TranslationMemoryService) GetTM(ctx context.Context, tmID int) (*model.
 // ListTMs returns a list of translation memories.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.getMany
-func (s *TranslationMemoryService) ListTMs(ctx context.Context, opts *model.TranslationMemoriesListOptions) (
+func (s *TranslationMemoryService) ListTMs(ctx context.Context, opts *model.TranslationMemoriesListOptions, reqOpts ...RequestOption) (
 	[]*model.TranslationMemory, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.ListTMs")
 	res := new(model.TranslationMemoriesListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/tms", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/tms", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -52,11 +55,12 @@ func (s *TranslationMemoryService) ListTMs(ctx context.Context, opts *model.Tran
 // AddTM creates a new translation memory.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.post
-func (s *TranslationMemoryService) AddTM(ctx context.Context, req *model.TranslationMemoryAddRequest) (
+func (s *TranslationMemoryService) AddTM(ctx context.Context, req *model.TranslationMemoryAddRequest, reqOpts ...RequestOption) (
 	*model.TranslationMemory, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.AddTM")
 	res := new(model.TranslationMemoryResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/tms", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/tms", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -69,11 +73,12 @@ func (s *TranslationMemoryService) AddTM(ctx context.Context, req *model.Transla
 // - value (string): Value to set.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.patch
-func (s *TranslationMemoryService) EditTM(ctx context.Context, tmID int, req []*model.UpdateRequest) (
+func (s *TranslationMemoryService) EditTM(ctx context.Context, tmID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.TranslationMemory, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.EditTM")
 	res := new(model.TranslationMemoryResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -81,18 +86,20 @@ func (s *TranslationMemoryService) EditTM(ctx context.Context, tmID int, req []*
 // DeleteTM removes a specific translation memory by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.delete
-func (s *TranslationMemoryService) DeleteTM(ctx context.Context, tmID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID), nil)
+func (s *TranslationMemoryService) DeleteTM(ctx context.Context, tmID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TranslationMemoryService.DeleteTM")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d", tmID), nil, reqOpts...)
 }
 
 // ExportTM creates a new translation memory export.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.exports.post
-func (s *TranslationMemoryService) ExportTM(ctx context.Context, tmID int, req *model.TranslationMemoryExportRequest) (
+func (s *TranslationMemoryService) ExportTM(ctx context.Context, tmID int, req *model.TranslationMemoryExportRequest, reqOpts ...RequestOption) (
 	*model.TranslationMemoryExport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.ExportTM")
 	res := new(model.TranslationMemoryExportResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/exports", tmID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/exports", tmID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -100,23 +107,35 @@ func (s *TranslationMemoryService) ExportTM(ctx context.Context, tmID int, req *
 // CheckTMExportStatus returns the status of a specific translation memory export.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.exports.get
-func (s *TranslationMemoryService) CheckTMExportStatus(ctx context.Context, tmID int, exportID string) (
+func (s *TranslationMemoryService) CheckTMExportStatus(ctx context.Context, tmID int, exportID string, reqOpts ...RequestOption) (
 	*model.TranslationMemoryExport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.CheckTMExportStatus")
 	res := new(model.TranslationMemoryExportResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/exports/%s", tmID, exportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/exports/%s", tmID, exportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// TMExportOperation returns an operation polling the status of the TM export
+// with CheckTMExportStatus.
+func (s *TranslationMemoryService) TMExportOperation(tmID int, exportID string, reqOpts ...RequestOption) *Operation[model.TranslationMemoryExport] {
+	return newOperation(func(ctx context.Context) (*model.TranslationMemoryExport, *Response, error) {
+		return s.CheckTMExportStatus(ctx, tmID, exportID, reqOpts...)
+	}, func(v *model.TranslationMemoryExport) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // DownloadTM returns a download link for a specific translation memory export.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.exports.download.download
-func (s *TranslationMemoryService) DownloadTM(ctx context.Context, tmID int, exportID string) (
+func (s *TranslationMemoryService) DownloadTM(ctx context.Context, tmID int, exportID string, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.DownloadTM")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/exports/%s/download", tmID, exportID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/exports/%s/download", tmID, exportID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -124,35 +143,70 @@ func (s *TranslationMemoryService) DownloadTM(ctx context.Context, tmID int, exp
 // ImportTM creates a new translation memory import.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.imports.post
-func (s *TranslationMemoryService) ImportTM(ctx context.Context, tmID int, req *model.TranslationMemoryImportRequest) (
+func (s *TranslationMemoryService) ImportTM(ctx context.Context, tmID int, req *model.TranslationMemoryImportRequest, reqOpts ...RequestOption) (
 	*model.TranslationMemoryImport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.ImportTM")
 	res := new(model.TranslationMemoryImportResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/imports", tmID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/imports", tmID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// ImportTMFromReader uploads the content read from `r` to the storage, imports
+// it to the translation memory and waits for the import to end. It returns the
+// last status of the import. The storage is deleted once the import has ended.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.tms.imports.post
+func (s *TranslationMemoryService) ImportTMFromReader(ctx context.Context, tmID int, name string, r io.Reader, req *model.TranslationMemoryImportRequest,
+	reqOpts ...RequestOption,
+) (*model.TranslationMemoryImport, *Response, error) {
+	ctx = withOperation(ctx, "TranslationMemoryService.ImportTMFromReader")
+	start := func(storageID int) (*model.TranslationMemoryImport, *Response, error) {
+		imp := model.TranslationMemoryImportRequest{}
+		if req != nil {
+			imp = *req
+		}
+		imp.StorageID = storageID
+		return s.ImportTM(ctx, tmID, &imp, reqOpts...)
+	}
+	return withImportStorage(ctx, s.client.Storages, name, r, reqOpts, start, func(imp *model.TranslationMemoryImport) *Operation[model.TranslationMemoryImport] {
+		return s.TMImportOperation(tmID, imp.Identifier, reqOpts...)
+	})
+}
+
 // CheckTMImportStatus returns the status of a specific translation memory import.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.imports.get
-func (s *TranslationMemoryService) CheckTMImportStatus(ctx context.Context, tmID int, importID string) (
+func (s *TranslationMemoryService) CheckTMImportStatus(ctx context.Context, tmID int, importID string, reqOpts ...RequestOption) (
 	*model.TranslationMemoryImport, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.CheckTMImportStatus")
 	res := new(model.TranslationMemoryImportResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/imports/%s", tmID, importID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/imports/%s", tmID, importID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// TMImportOperation returns an operation polling the status of the TM import
+// with CheckTMImportStatus.
+func (s *TranslationMemoryService) TMImportOperation(tmID int, importID string, reqOpts ...RequestOption) *Operation[model.TranslationMemoryImport] {
+	return newOperation(func(ctx context.Context) (*model.TranslationMemoryImport, *Response, error) {
+		return s.CheckTMImportStatus(ctx, tmID, importID, reqOpts...)
+	}, func(v *model.TranslationMemoryImport) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // ConcordanceSearch searches for concordance in a translation memory.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.tms.concordance.post
-func (s *TranslationMemoryService) ConcordanceSearch(ctx context.Context, projectID int, req *model.TMConcordanceSearchRequest) (
+func (s *TranslationMemoryService) ConcordanceSearch(ctx context.Context, projectID int, req *model.TMConcordanceSearchRequest, reqOpts ...RequestOption) (
 	[]*model.TMConcordanceSearch, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.ConcordanceSearch")
 	res := new(model.TMConcordanceSearchResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tms/concordance", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/tms/concordance", projectID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -168,11 +222,12 @@ func (s *TranslationMemoryService) ConcordanceSearch(ctx context.Context, projec
 // GetTMSegment returns a specific translation memory segment by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.get
-func (s *TranslationMemoryService) GetTMSegment(ctx context.Context, tmID, segmentID int) (
+func (s *TranslationMemoryService) GetTMSegment(ctx context.Context, tmID, segmentID int, reqOpts ...RequestOption) (
 	*model.TMSegment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.GetTMSegment")
 	res := new(model.TMSegmentResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -180,11 +235,12 @@ func (s *TranslationMemoryService) GetTMSegment(ctx context.Context, tmID, segme
 // ListTMSegments returns a list of translation memory segments.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.getMany
-func (s *TranslationMemoryService) ListTMSegments(ctx context.Context, tmID int, opts *model.TMSegmentsListOptions) (
+func (s *TranslationMemoryService) ListTMSegments(ctx context.Context, tmID int, opts *model.TMSegmentsListOptions, reqOpts ...RequestOption) (
 	[]*model.TMSegment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.ListTMSegments")
 	res := new(model.TMSegmentsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -200,11 +256,12 @@ func (s *TranslationMemoryService) ListTMSegments(ctx context.Context, tmID int,
 // CreateTMSegment creates a new translation memory segment.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.post
-func (s *TranslationMemoryService) CreateTMSegment(ctx context.Context, tmID int, req *model.TMSegmentCreateRequest) (
+func (s *TranslationMemoryService) CreateTMSegment(ctx context.Context, tmID int, req *model.TMSegmentCreateRequest, reqOpts ...RequestOption) (
 	*model.TMSegment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.CreateTMSegment")
 	res := new(model.TMSegmentResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -228,11 +285,12 @@ func (s *TranslationMemoryService) CreateTMSegment(ctx context.Context, tmID int
 //   - path (string): "/records/{recordId}".
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.patch
-func (s *TranslationMemoryService) EditTMSegment(ctx context.Context, tmID, segmentID int, req []*model.UpdateRequest) (
+func (s *TranslationMemoryService) EditTMSegment(ctx context.Context, tmID, segmentID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.TMSegment, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationMemoryService.EditTMSegment")
 	res := new(model.TMSegmentResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -240,13 +298,15 @@ func (s *TranslationMemoryService) EditTMSegment(ctx context.Context, tmID, segm
 // DeleteTMSegment removes a specific translation memory segment by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.delete
-func (s *TranslationMemoryService) DeleteTMSegment(ctx context.Context, tmID, segmentID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID), nil)
+func (s *TranslationMemoryService) DeleteTMSegment(ctx context.Context, tmID, segmentID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TranslationMemoryService.DeleteTMSegment")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d/segments/%d", tmID, segmentID), nil, reqOpts...)
 }
 
 // ClearTM removes all segments from a specific translation memory.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.tms.segments.clear
-func (s *TranslationMemoryService) ClearTM(ctx context.Context, tmID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID), nil)
+func (s *TranslationMemoryService) ClearTM(ctx context.Context, tmID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TranslationMemoryService.ClearTM")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/tms/%d/segments", tmID), nil, reqOpts...)
 }
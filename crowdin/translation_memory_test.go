@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
@@ -978,3 +979,26 @@ func TestTranslationMemoryService_DeleteTMSegment(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
 }
+
+func TestTranslationMemoryService_ImportTMFromReader(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "<tmx/>")
+	mux.HandleFunc("/api/v2/tms/4/imports", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, `{"storageId": 61}`)
+		fmt.Fprint(w, `{"data": {"identifier": "b5215a34", "status": "created"}}`)
+	})
+	// The import reads the storage after the import request has returned.
+	mux.HandleFunc("/api/v2/tms/4/imports/b5215a34", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		assert.Empty(t, *deleted, "storage is deleted before the import has read it")
+		fmt.Fprint(w, `{"data": {"identifier": "b5215a34", "status": "failed", "progress": 40}}`)
+	})
+
+	imp, _, err := client.TranslationMemory.ImportTMFromReader(context.Background(), 4, "memory.tmx", strings.NewReader("<tmx/>"), nil)
+	require.ErrorIs(t, err, ErrOperationFailed)
+	assert.Equal(t, "failed", imp.Status)
+	assert.Equal(t, []string{"61"}, *deleted, "storage is deleted once the import has ended")
+}
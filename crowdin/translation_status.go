@@ -18,56 +18,62 @@ type TranslationStatusService struct {
 // GetBranchProgress returns the translation and proofreading progress on a branch level.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.branches.languages.progress.getMany
-func (s *TranslationStatusService) GetBranchProgress(ctx context.Context, projectID, branchID int, opts *model.ListOptions) (
+func (s *TranslationStatusService) GetBranchProgress(ctx context.Context, projectID, branchID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
-	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/languages/progress", projectID, branchID), opts)
+	ctx = withOperation(ctx, "TranslationStatusService.GetBranchProgress")
+	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/branches/%d/languages/progress", projectID, branchID), opts, reqOpts...)
 }
 
 // GetDirectoryProgress returns the translation and proofreading progress on a directory level.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.directories.languages.progress.getMany
-func (s *TranslationStatusService) GetDirectoryProgress(ctx context.Context, projectID, directoryID int, opts *model.ListOptions) (
+func (s *TranslationStatusService) GetDirectoryProgress(ctx context.Context, projectID, directoryID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
-	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d/languages/progress", projectID, directoryID), opts)
+	ctx = withOperation(ctx, "TranslationStatusService.GetDirectoryProgress")
+	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/directories/%d/languages/progress", projectID, directoryID), opts, reqOpts...)
 }
 
 // GetFileProgress returns the translation and proofreading progress on a file level.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.files.languages.progress.getMany
-func (s *TranslationStatusService) GetFileProgress(ctx context.Context, projectID, fileID int, opts *model.ListOptions) (
+func (s *TranslationStatusService) GetFileProgress(ctx context.Context, projectID, fileID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
-	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/languages/progress", projectID, fileID), opts)
+	ctx = withOperation(ctx, "TranslationStatusService.GetFileProgress")
+	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/files/%d/languages/progress", projectID, fileID), opts, reqOpts...)
 }
 
 // GetLanguageProgress returns the translation and proofreading progress on a language level.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.languages.files.progress.getMany
-func (s *TranslationStatusService) GetLanguageProgress(ctx context.Context, projectID int, languageID string, opts *model.ListOptions) (
+func (s *TranslationStatusService) GetLanguageProgress(ctx context.Context, projectID int, languageID string, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
-	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/%s/progress", projectID, languageID), opts)
+	ctx = withOperation(ctx, "TranslationStatusService.GetLanguageProgress")
+	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/%s/progress", projectID, languageID), opts, reqOpts...)
 }
 
 // GetProjectProgress returns the translation and proofreading progress on a project level.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.languages.progress.getMany
-func (s *TranslationStatusService) GetProjectProgress(ctx context.Context, projectID int, opts *model.ProjectProgressListOptions) (
+func (s *TranslationStatusService) GetProjectProgress(ctx context.Context, projectID int, opts *model.ProjectProgressListOptions, reqOpts ...RequestOption) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
-	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/progress", projectID), opts)
+	ctx = withOperation(ctx, "TranslationStatusService.GetProjectProgress")
+	return s.progress(ctx, fmt.Sprintf("/api/v2/projects/%d/languages/progress", projectID), opts, reqOpts...)
 }
 
 // ListQAChecks returns a list of QA check issues.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.qa-checks.getMany
-func (s *TranslationStatusService) ListQAChecks(ctx context.Context, projectID int, opts *model.QACheckListOptions) (
+func (s *TranslationStatusService) ListQAChecks(ctx context.Context, projectID int, opts *model.QACheckListOptions, reqOpts ...RequestOption) (
 	[]*model.QACheck, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationStatusService.ListQAChecks")
 	res := new(model.QAChecksResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/qa-checks", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/qa-checks", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -80,11 +86,11 @@ func (s *TranslationStatusService) ListQAChecks(ctx context.Context, projectID i
 	return issues, resp, nil
 }
 
-func (s *TranslationStatusService) progress(ctx context.Context, path string, opts ListOptionsProvider) (
+func (s *TranslationStatusService) progress(ctx context.Context, path string, opts ListOptionsProvider, reqOpts ...RequestOption) (
 	[]*model.TranslationProgress, *Response, error,
 ) {
 	res := new(model.TranslationProgressResponse)
-	resp, err := s.client.Get(ctx, path, opts, res)
+	resp, err := s.client.Get(ctx, path, opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -3,6 +3,7 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
 )
@@ -22,23 +23,35 @@ type TranslationsService struct {
 // PreTranslationStatus returns a pre-translation status for project by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.pre-translations.get
-func (s *TranslationsService) PreTranslationStatus(ctx context.Context, projectID int, preTranslationID string) (
+func (s *TranslationsService) PreTranslationStatus(ctx context.Context, projectID int, preTranslationID string, reqOpts ...RequestOption) (
 	*model.PreTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.PreTranslationStatus")
 	res := new(model.PreTranslationsResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations/%s", projectID, preTranslationID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations/%s", projectID, preTranslationID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// PreTranslationOperation returns an operation polling the status of the pre-translation
+// with PreTranslationStatus.
+func (s *TranslationsService) PreTranslationOperation(projectID int, preTranslationID string, reqOpts ...RequestOption) *Operation[model.PreTranslation] {
+	return newOperation(func(ctx context.Context) (*model.PreTranslation, *Response, error) {
+		return s.PreTranslationStatus(ctx, projectID, preTranslationID, reqOpts...)
+	}, func(v *model.PreTranslation) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // List Pre-Translations returns a list of pre-translations for a specific project.
 //
 // https://support.crowdin.com/developer/api/v2/#tag/Translations/operation/api.projects.pre-translations.getMany
-func (s *TranslationsService) ListPreTranslations(ctx context.Context, projectID int, opts *model.ListOptions) (
+func (s *TranslationsService) ListPreTranslations(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.PreTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.ListPreTranslations")
 	res := new(model.PreTranslationsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations", projectID), opts, res, reqOpts...)
 
 	list := make([]*model.PreTranslation, 0, len(res.Data))
 	for _, preTranslation := range res.Data {
@@ -58,9 +71,11 @@ func (s *TranslationsService) ListPreTranslations(ctx context.Context, projectID
 // https://support.crowdin.com/developer/api/v2/#tag/Translations/operation/api.projects.pre-translations.patch
 func (s *TranslationsService) EditPreTranslation(
 	ctx context.Context, projectID int, preTranslationID string, req []*model.UpdateRequest,
+	reqOpts ...RequestOption,
 ) (*model.PreTranslation, *Response, error) {
+	ctx = withOperation(ctx, "TranslationsService.EditPreTranslation")
 	res := new(model.PreTranslationsResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations/%s", projectID, preTranslationID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations/%s", projectID, preTranslationID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -68,11 +83,12 @@ func (s *TranslationsService) EditPreTranslation(
 // ApplyPreTranslation applies pre-translation to the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.pre-translations.post
-func (s *TranslationsService) ApplyPreTranslation(ctx context.Context, projectID int, req *model.PreTranslationRequest) (
+func (s *TranslationsService) ApplyPreTranslation(ctx context.Context, projectID int, req *model.PreTranslationRequest, reqOpts ...RequestOption) (
 	*model.PreTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.ApplyPreTranslation")
 	res := new(model.PreTranslationsResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -82,9 +98,11 @@ func (s *TranslationsService) ApplyPreTranslation(ctx context.Context, projectID
 // https://support.crowdin.com/developer/api/v2/#tag/Translations/operation/api.projects.pre-translations.report.getReport
 func (s *TranslationsService) PreTranslationReport(
 	ctx context.Context, projectID int, preTranslationID string,
+	reqOpts ...RequestOption,
 ) (*model.PreTranslationReport, *Response, error) {
+	ctx = withOperation(ctx, "TranslationsService.PreTranslationReport")
 	res := new(model.PreTranslationReportResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations/%s/reports", projectID, preTranslationID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations/%s/reports", projectID, preTranslationID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -96,12 +114,14 @@ func (s *TranslationsService) BuildProjectDirectoryTranslation(
 	ctx context.Context,
 	projectID, directoryID int,
 	req *model.BuildProjectDirectoryTranslationRequest,
+	reqOpts ...RequestOption,
 ) (*model.BuildProjectDirectoryTranslation, *Response, error) {
+	ctx = withOperation(ctx, "TranslationsService.BuildProjectDirectoryTranslation")
 	res := struct {
 		Data *model.BuildProjectDirectoryTranslation `json:"data"`
 	}{}
 	path := fmt.Sprintf("/api/v2/projects/%d/translations/builds/directories/%d", projectID, directoryID)
-	resp, err := s.client.Post(ctx, path, req, &res)
+	resp, err := s.client.Post(ctx, path, req, &res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -118,10 +138,12 @@ func (s *TranslationsService) BuildProjectFileTranslation(
 	projectID, fileID int,
 	req *model.BuildProjectFileTranslationRequest,
 	etag string,
+	reqOpts ...RequestOption,
 ) (*model.DownloadLink, *Response, error) {
+	ctx = withOperation(ctx, "TranslationsService.BuildProjectFileTranslation")
 	path := fmt.Sprintf("/api/v2/projects/%d/translations/builds/files/%d", projectID, fileID)
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Post(ctx, path, req, res, Header("If-None-Match", etag))
+	resp, err := s.client.Post(ctx, path, req, res, append([]RequestOption{Header("If-None-Match", etag)}, reqOpts...)...)
 
 	return res.Data, resp, err
 }
@@ -129,11 +151,12 @@ func (s *TranslationsService) BuildProjectFileTranslation(
 // ListProjectBuilds returns a list of builds for a specific project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.builds.getMany
-func (s *TranslationsService) ListProjectBuilds(ctx context.Context, projectID int, opts *model.TranslationsBuildsListOptions) (
+func (s *TranslationsService) ListProjectBuilds(ctx context.Context, projectID int, opts *model.TranslationsBuildsListOptions, reqOpts ...RequestOption) (
 	[]*model.TranslationsProjectBuild, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.ListProjectBuilds")
 	res := new(model.TranslationsProjectBuildsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -150,11 +173,12 @@ func (s *TranslationsService) ListProjectBuilds(ctx context.Context, projectID i
 // Request body can be either `model.BuildProjectRequest` or `model.PseudoBuildProjectRequest`.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.builds.post
-func (s *TranslationsService) BuildProjectTranslation(ctx context.Context, projectID int, req model.BuildProjectTranslationRequester) (
+func (s *TranslationsService) BuildProjectTranslation(ctx context.Context, projectID int, req model.BuildProjectTranslationRequester, reqOpts ...RequestOption) (
 	*model.TranslationsProjectBuild, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.BuildProjectTranslation")
 	res := new(model.TranslationsProjectBuildResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds", projectID), req, &res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds", projectID), req, &res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -162,23 +186,62 @@ func (s *TranslationsService) BuildProjectTranslation(ctx context.Context, proje
 // UploadTranslations uploads translations for a specific language in the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.postOnLanguage
-func (s *TranslationsService) UploadTranslations(ctx context.Context, projectID int, languageID string, req *model.UploadTranslationsRequest) (
+func (s *TranslationsService) UploadTranslations(ctx context.Context, projectID int, languageID string, req *model.UploadTranslationsRequest, reqOpts ...RequestOption) (
 	*model.UploadTranslations, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.UploadTranslations")
 	res := new(model.UploadTranslationsResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%s", projectID, languageID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/%s", projectID, languageID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// UploadTranslationsFromReader uploads the content read from `r` to the storage
+// and imports it as the translations for a specific language in the project.
+//
+// The translations may be imported after the response, and the API does not
+// report when, so the storage is not deleted. It expires after 24 hours, or
+// can be deleted with StorageService.Delete and the StorageID of the result
+// once the translations are imported. If the server rejects the import, the
+// storage is deleted. If the import fails otherwise, e.g. the context is
+// canceled, the result holds the StorageID along with the error.
+//
+// https://developer.crowdin.com/api/v2/#operation/api.projects.translations.postOnLanguage
+func (s *TranslationsService) UploadTranslationsFromReader(ctx context.Context, projectID int, languageID, name string, r io.Reader,
+	req *model.UploadTranslationsRequest, reqOpts ...RequestOption,
+) (*model.UploadTranslations, *Response, error) {
+	ctx = withOperation(ctx, "TranslationsService.UploadTranslationsFromReader")
+	storage, resp, err := s.client.Storages.AddReader(ctx, name, r, nil, reqOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	upload := model.UploadTranslationsRequest{}
+	if req != nil {
+		upload = *req
+	}
+	upload.StorageID = storage.ID
+	res, resp, err := s.UploadTranslations(ctx, projectID, languageID, &upload, reqOpts...)
+	if err != nil {
+		if resp != nil && resp.Response != nil {
+			_, _ = s.client.Storages.Delete(context.WithoutCancel(ctx), storage.ID, reqOpts...)
+			return nil, resp, err
+		}
+		// The request may have reached the server, which may still read the storage.
+		return &model.UploadTranslations{ProjectID: projectID, StorageID: storage.ID, LanguageID: languageID, FileID: upload.FileID}, resp, err
+	}
+	return res, resp, nil
+}
+
 // DownloadProjectTranslations returns a download link for a specific build.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.builds.download.download
-func (s *TranslationsService) DownloadProjectTranslations(ctx context.Context, projectID, buildID int) (
+func (s *TranslationsService) DownloadProjectTranslations(ctx context.Context, projectID, buildID int, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.DownloadProjectTranslations")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d/download", projectID, buildID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d/download", projectID, buildID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -186,20 +249,32 @@ func (s *TranslationsService) DownloadProjectTranslations(ctx context.Context, p
 // CheckBuildStatus checks the status of a project build by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.builds.get
-func (s *TranslationsService) CheckBuildStatus(ctx context.Context, projectID, buildID int) (
+func (s *TranslationsService) CheckBuildStatus(ctx context.Context, projectID, buildID int, reqOpts ...RequestOption) (
 	*model.TranslationsProjectBuild, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.CheckBuildStatus")
 	res := new(model.TranslationsProjectBuildResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d", projectID, buildID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d", projectID, buildID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
+// BuildOperation returns an operation polling the status of the project translations build
+// with CheckBuildStatus.
+func (s *TranslationsService) BuildOperation(projectID, buildID int, reqOpts ...RequestOption) *Operation[model.TranslationsProjectBuild] {
+	return newOperation(func(ctx context.Context) (*model.TranslationsProjectBuild, *Response, error) {
+		return s.CheckBuildStatus(ctx, projectID, buildID, reqOpts...)
+	}, func(v *model.TranslationsProjectBuild) (string, int) {
+		return v.Status, v.Progress
+	})
+}
+
 // CancelBuild cancels a build by its identifier.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.builds.delete
-func (s *TranslationsService) CancelBuild(ctx context.Context, projectID, buildID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d", projectID, buildID), nil)
+func (s *TranslationsService) CancelBuild(ctx context.Context, projectID, buildID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "TranslationsService.CancelBuild")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/builds/%d", projectID, buildID), nil, reqOpts...)
 }
 
 // ExportProjectTranslation exports project translations for a specific language.
@@ -208,11 +283,12 @@ func (s *TranslationsService) CancelBuild(ctx context.Context, projectID, buildI
 // it is recommended to use OTA.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.translations.exports.post
-func (s *TranslationsService) ExportProjectTranslation(ctx context.Context, projectID int, req *model.ExportTranslationRequest) (
+func (s *TranslationsService) ExportProjectTranslation(ctx context.Context, projectID int, req *model.ExportTranslationRequest, reqOpts ...RequestOption) (
 	*model.DownloadLink, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.ExportProjectTranslation")
 	res := new(model.DownloadLinkResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/exports", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/translations/exports", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -220,11 +296,12 @@ func (s *TranslationsService) ExportProjectTranslation(ctx context.Context, proj
 // BatchPreTranslation executes a batch pre-translation operation for the given project
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.pre-translations.patchBatch
-func (s *TranslationsService) BatchPreTranslation(ctx context.Context, projectID int, req []*model.UpdateRequest) (
+func (s *TranslationsService) BatchPreTranslation(ctx context.Context, projectID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	[]*model.PreTranslation, *Response, error,
 ) {
+	ctx = withOperation(ctx, "TranslationsService.BatchPreTranslation")
 	res := new(model.PreTranslationsListResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations", projectID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/pre-translations", projectID), req, res, reqOpts...)
 
 	list := make([]*model.PreTranslation, 0, len(res.Data))
 	for _, preTranslation := range res.Data {
@@ -0,0 +1,270 @@
+package crowdin
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// BuildAndDownloadOptions specifies the optional parameters to the
+// TranslationsService.BuildAndDownload method.
+type BuildAndDownloadOptions struct {
+	// Poll configures how the status of the build is polled.
+	Poll []PollOption
+	// Download configures the download of the archive. The Refresh
+	// function is set to request a new download link of the build.
+	Download *DownloadOptions
+	// MaxExtractedSize is the maximum total size of the extracted files.
+	// Defaults to DefaultMaxExtractedSize.
+	MaxExtractedSize int64
+}
+
+// DefaultMaxExtractedSize is the default maximum total size
+// of the files extracted from a build archive.
+const DefaultMaxExtractedSize = 1 << 30
+
+// ErrArchiveTooLarge is returned when the files of a build archive
+// exceed the maximum extracted size.
+var ErrArchiveTooLarge = errors.New("crowdin: archive exceeds the maximum extracted size")
+
+// BuildDownload is the result of the TranslationsService.BuildAndDownload method.
+type BuildDownload struct {
+	// Build is the finished build.
+	Build *model.TranslationsProjectBuild
+	// Reused reports whether the build was already finished when it
+	// was requested, i.e. Crowdin returned an up-to-date build.
+	Reused bool
+	// Files maps the language identifiers to the slash-separated paths of
+	// the extracted files relative to the target directory. The files of
+	// the archive whose language cannot be detected from their path are
+	// listed under the empty key.
+	Files map[string][]string
+}
+
+// BuildAndDownload builds the project translations, waits for the build to
+// finish, downloads the archive and extracts it into the directory.
+// Request body can be either `model.BuildProjectRequest` or `model.PseudoBuildProjectRequest`.
+//
+// If Crowdin returns a finished build, it is downloaded without polling.
+// The language of the extracted files is detected from their paths using
+// the codes of the target languages and the language mapping of the project.
+func (s *TranslationsService) BuildAndDownload(ctx context.Context, projectID int, req model.BuildProjectTranslationRequester, dir string,
+	opts *BuildAndDownloadOptions, reqOpts ...RequestOption,
+) (*BuildDownload, error) {
+	ctx = withOperation(ctx, "TranslationsService.BuildAndDownload")
+	if opts == nil {
+		opts = &BuildAndDownloadOptions{}
+	}
+
+	build, _, err := s.BuildProjectTranslation(ctx, projectID, req, reqOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if build == nil {
+		return nil, errors.New("crowdin: build is empty")
+	}
+
+	res := &BuildDownload{Build: build, Reused: build.Status == OperationFinished}
+	if !res.Reused {
+		if res.Build, err = s.BuildOperation(projectID, build.ID, reqOpts...).Wait(ctx, opts.Poll...); err != nil {
+			return res, err
+		}
+	}
+
+	project, _, err := s.client.Projects.Get(ctx, projectID, reqOpts...)
+	if err != nil {
+		return res, err
+	}
+
+	link, _, err := s.DownloadProjectTranslations(ctx, projectID, build.ID, reqOpts...)
+	if err != nil {
+		return res, err
+	}
+
+	archive, err := os.CreateTemp("", "crowdin-build-*.zip")
+	if err != nil {
+		return res, err
+	}
+	defer func() {
+		archive.Close()
+		os.Remove(archive.Name())
+	}()
+
+	dlOpts := DownloadOptions{}
+	if opts.Download != nil {
+		dlOpts = *opts.Download
+	}
+	dlOpts.Refresh = func(ctx context.Context) (*model.DownloadLink, error) {
+		link, _, err := s.DownloadProjectTranslations(ctx, projectID, build.ID, reqOpts...)
+		return link, err
+	}
+	size, err := s.client.Download(ctx, link, archive, &dlOpts)
+	if err != nil {
+		return res, err
+	}
+
+	limit := opts.MaxExtractedSize
+	if limit <= 0 {
+		limit = DefaultMaxExtractedSize
+	}
+	files, err := extractZip(archive, size, dir, limit)
+	if err != nil {
+		return res, err
+	}
+	res.Files = groupByLanguage(files, buildLanguages(project, res.Build))
+
+	return res, nil
+}
+
+// extractZip extracts the archive into the directory and returns the
+// slash-separated paths of the extracted files. Entries with paths
+// outside the directory or that are not regular files are rejected,
+// as well as archives whose files exceed limit bytes in total.
+func extractZip(r io.ReaderAt, size int64, dir string, limit int64) ([]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("crowdin: cannot read archive: %w", err)
+	}
+
+	// Check all the entries before writing anything.
+	var total uint64
+	for _, f := range zr.File {
+		if total += f.UncompressedSize64; total > uint64(limit) { //nolint:gosec // limit is positive
+			return nil, ErrArchiveTooLarge
+		}
+		if !filepath.IsLocal(filepath.FromSlash(f.Name)) {
+			return nil, fmt.Errorf("crowdin: illegal file path in archive: %q", f.Name)
+		}
+		if !f.Mode().IsRegular() && !f.Mode().IsDir() {
+			return nil, fmt.Errorf("crowdin: unsupported file type in archive: %q", f.Name)
+		}
+	}
+
+	files := make([]string, 0, len(zr.File))
+	for _, f := range zr.File {
+		target := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if f.Mode().IsDir() {
+			if err := os.MkdirAll(target, 0o755); err != nil { //nolint:gosec // extracted directories are not secret
+				return files, err
+			}
+			continue
+		}
+		if err := extractFile(f, target); err != nil {
+			return files, err
+		}
+		files = append(files, path.Clean(f.Name))
+	}
+
+	return files, nil
+}
+
+// extractFile extracts the entry to the target path. The entry is read up
+// to its declared size, which extractZip has checked against the limit.
+func extractFile(f *zip.File, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil { //nolint:gosec // extracted directories are not secret
+		return err
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	w, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644) //nolint:gosec // extracted files are not secret
+	if err != nil {
+		return err
+	}
+	declared := int64(min(f.UncompressedSize64, math.MaxInt64)) //nolint:gosec // clamped to the int64 range
+	n, err := io.Copy(w, io.LimitReader(rc, declared+1))
+	if err == nil && n > declared {
+		err = fmt.Errorf("crowdin: file %q in archive is larger than declared", f.Name)
+	}
+	if err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// buildLanguages returns the codes identifying the target languages of the
+// build, i.e. the language identifiers, the language codes and the codes
+// of the language mapping of the project.
+func buildLanguages(project *model.Project, build *model.TranslationsProjectBuild) map[string][]string {
+	var targets []string
+	if build != nil && build.Attributes != nil {
+		targets = build.Attributes.TargetLanguageIDs
+	}
+
+	languages := make(map[string][]string)
+	for _, lang := range project.TargetLanguages {
+		if lang == nil || (len(targets) > 0 && !slices.Contains(targets, lang.ID)) {
+			continue
+		}
+		codes := []string{
+			lang.ID, lang.Locale, strings.ReplaceAll(lang.Locale, "-", "_"), lang.TwoLettersCode,
+			lang.ThreeLettersCode, lang.AndroidCode, lang.OSXCode, lang.OSXLocale,
+		}
+		if m, ok := project.LanguageMapping[lang.ID]; ok {
+			codes = append(codes, m.Name, m.TwoLettersCode, m.ThreeLettersCode, m.Locale,
+				m.LocaleWithUnderscore, m.AndroidCode, m.OSXCode, m.OSXLocale)
+		}
+		languages[lang.ID] = codes
+	}
+	return languages
+}
+
+// groupByLanguage groups the files by the language whose code is a segment
+// of their path, e.g. "fr/strings.xml", "values-fr/strings.xml" or
+// "messages.fr.json". The longest matching code wins; the files matched by
+// several languages with the same code are not assigned to any language.
+func groupByLanguage(files []string, languages map[string][]string) map[string][]string {
+	groups := make(map[string][]string)
+	for _, file := range files {
+		segments := pathSegments(file)
+
+		var (
+			lang    string
+			longest int
+		)
+		for id, codes := range languages {
+			for _, code := range codes {
+				if code == "" || len(code) < longest || !slices.Contains(segments, strings.ToLower(code)) {
+					continue
+				}
+				if len(code) == longest && lang != id {
+					lang = ""
+					continue
+				}
+				lang, longest = id, len(code)
+			}
+		}
+		groups[lang] = append(groups[lang], file)
+	}
+	return groups
+}
+
+// pathSegments returns the lower-cased directory names of the path and
+// the dot-separated parts of the file name without the extension.
+func pathSegments(p string) []string {
+	dir, name := path.Split(strings.ToLower(p))
+	segments := strings.FieldsFunc(dir, func(r rune) bool { return r == '/' })
+	segments = append(segments, strings.Split(strings.TrimSuffix(name, path.Ext(name)), ".")...)
+	for _, s := range segments {
+		// Android resource directories, e.g. "values-fr" or "values-fr-rCA".
+		if code, ok := strings.CutPrefix(s, "values-"); ok {
+			segments = append(segments, code)
+		}
+	}
+	return segments
+}
@@ -0,0 +1,200 @@
+package crowdin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func handleBuildAndDownload(t *testing.T, mux *http.ServeMux, client *Client, buildStatus string, archive []byte) {
+	t.Helper()
+
+	mux.HandleFunc("/api/v2/projects/1/translations/builds", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprintf(w, `{"data": {"id": 2, "projectId": 1, "status": %q, "progress": 0}}`, buildStatus)
+	})
+	mux.HandleFunc("/api/v2/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"data": {"id": 1, "targetLanguages": [
+			{"id": "fr", "twoLettersCode": "fr", "locale": "fr-FR", "androidCode": "fr-rFR"},
+			{"id": "pt-BR", "twoLettersCode": "pt", "locale": "pt-BR", "androidCode": "pt-rBR"},
+			{"id": "pt-PT", "twoLettersCode": "pt", "locale": "pt-PT", "androidCode": "pt-rPT"},
+			{"id": "uk", "twoLettersCode": "uk", "locale": "uk-UA"}
+		], "languageMapping": {"uk": {"locale": "ua"}}}}`)
+	})
+	mux.HandleFunc("/api/v2/projects/1/translations/builds/2/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"data": {"url": "%sarchive.zip", "expireIn": %q}}`, client.baseURL, time.Now().Add(time.Hour).Format(time.RFC3339))
+	})
+	mux.HandleFunc("/archive.zip", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("Authorization header is sent with the archive request")
+		}
+		_, _ = w.Write(archive)
+	})
+}
+
+func TestTranslationsService_BuildAndDownload(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	archive := zipArchive(t, map[string]string{
+		"fr/strings.json":         "fr",
+		"res/values-pt-rBR/a.xml": "pt-BR",
+		"pt/readme.md":            "pt",
+		"messages.ua.json":        "uk",
+		"README.md":               "none",
+	})
+	handleBuildAndDownload(t, mux, client, "inProgress", archive)
+	calls := handleStatuses(t, mux, "/api/v2/projects/1/translations/builds/2", "inProgress", "finished")
+
+	dir := t.TempDir()
+	res, err := client.Translations.BuildAndDownload(context.Background(), 1, &model.BuildProjectRequest{}, dir,
+		&BuildAndDownloadOptions{Poll: []PollOption{PollInterval(time.Millisecond)}})
+	require.NoError(t, err)
+
+	assert.False(t, res.Reused)
+	assert.Equal(t, OperationFinished, res.Build.Status)
+	assert.Equal(t, 2, *calls)
+	assert.Equal(t, map[string][]string{
+		"fr":    {"fr/strings.json"},
+		"pt-BR": {"res/values-pt-rBR/a.xml"},
+		"uk":    {"messages.ua.json"},
+		"":      {"README.md", "pt/readme.md"},
+	}, sortedFiles(res.Files))
+
+	b, err := os.ReadFile(filepath.Join(dir, "res", "values-pt-rBR", "a.xml"))
+	require.NoError(t, err)
+	assert.Equal(t, "pt-BR", string(b))
+}
+
+func TestTranslationsService_BuildAndDownload_reusedBuild(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleBuildAndDownload(t, mux, client, "finished", zipArchive(t, map[string]string{"fr/strings.json": "fr"}))
+	mux.HandleFunc("/api/v2/projects/1/translations/builds/2", func(http.ResponseWriter, *http.Request) {
+		t.Error("Finished build is polled")
+	})
+
+	res, err := client.Translations.BuildAndDownload(context.Background(), 1, &model.PseudoBuildProjectRequest{}, t.TempDir(), nil)
+	require.NoError(t, err)
+
+	assert.True(t, res.Reused)
+	assert.Equal(t, map[string][]string{"fr": {"fr/strings.json"}}, res.Files)
+}
+
+func TestTranslationsService_BuildAndDownload_zipSlip(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleBuildAndDownload(t, mux, client, "finished", zipArchive(t, map[string]string{
+		"fr/strings.json":  "fr",
+		"../../etc/passwd": "evil",
+	}))
+
+	dir := t.TempDir()
+	_, err := client.Translations.BuildAndDownload(context.Background(), 1, &model.BuildProjectRequest{}, filepath.Join(dir, "out"), nil)
+	require.EqualError(t, err, `crowdin: illegal file path in archive: "../../etc/passwd"`)
+	assert.NoDirExists(t, filepath.Join(dir, "out"))
+}
+
+func TestTranslationsService_BuildAndDownload_buildFailed(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	handleBuildAndDownload(t, mux, client, "created", nil)
+	handleStatuses(t, mux, "/api/v2/projects/1/translations/builds/2", "failed")
+
+	_, err := client.Translations.BuildAndDownload(context.Background(), 1, &model.BuildProjectRequest{}, t.TempDir(), nil)
+	require.ErrorIs(t, err, ErrOperationFailed)
+}
+
+func TestExtractZip_rejectsSymlinks(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	h := &zip.FileHeader{Name: "link"}
+	h.SetMode(os.ModeSymlink | 0o777)
+	w, err := zw.CreateHeader(h)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("/etc/passwd"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	_, err = extractZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), t.TempDir(), DefaultMaxExtractedSize)
+	require.EqualError(t, err, `crowdin: unsupported file type in archive: "link"`)
+}
+
+func TestExtractZip_sizeLimit(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range []string{"fr/a.json", "fr/b.json"} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(bytes.Repeat([]byte("a"), 600))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	dir := t.TempDir()
+	_, err := extractZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), dir, 1000)
+	require.ErrorIs(t, err, ErrArchiveTooLarge)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "files are extracted before the size is checked")
+
+	files, err := extractZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), dir, 1200)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fr/a.json", "fr/b.json"}, files)
+}
+
+func TestExtractZip_undeclaredSize(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.CreateRaw(&zip.FileHeader{Name: "fr/a.json", Method: zip.Store, CompressedSize64: 600, UncompressedSize64: 10})
+	require.NoError(t, err)
+	_, err = w.Write(bytes.Repeat([]byte("a"), 600))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	dir := t.TempDir()
+	_, err = extractZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), dir, 100)
+	require.Error(t, err)
+	info, err := os.Stat(filepath.Join(dir, "fr", "a.json"))
+	require.NoError(t, err)
+	assert.LessOrEqual(t, info.Size(), int64(11))
+}
+
+func sortedFiles(files map[string][]string) map[string][]string {
+	for _, f := range files {
+		slices.Sort(f)
+	}
+	return files
+}
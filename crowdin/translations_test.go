@@ -3,7 +3,9 @@ package crowdin
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
@@ -1122,3 +1124,60 @@ func TestTranslationsService_BatchPreTranslation(t *testing.T) {
 	assert.Equal(t, expected, result[0])
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestTranslationsService_UploadTranslationsFromReader(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, `{"key": "valeur"}`)
+	mux.HandleFunc("/api/v2/projects/1/translations/fr", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testJSONBody(t, r, `{"storageId": 61, "fileId": 8}`)
+		fmt.Fprint(w, `{"data": {"projectId": 1, "storageId": 61, "languageId": "fr", "fileId": 8}}`)
+	})
+
+	upload, _, err := client.Translations.UploadTranslationsFromReader(context.Background(), 1, "fr", "fr.json", strings.NewReader(`{"key": "valeur"}`),
+		&model.UploadTranslationsRequest{FileID: 8})
+	require.NoError(t, err)
+	assert.Equal(t, 8, upload.FileID)
+	assert.Equal(t, 61, upload.StorageID)
+	assert.Empty(t, *deleted, "storage is deleted while the translations may be imported")
+}
+
+func TestTranslationsService_UploadTranslationsFromReader_rejected(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "content")
+	mux.HandleFunc("/api/v2/projects/1/translations/fr", func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, `{"error": {"code": 404, "message": "File Not Found"}}`, http.StatusNotFound)
+	})
+
+	upload, _, err := client.Translations.UploadTranslationsFromReader(context.Background(), 1, "fr", "fr.json", strings.NewReader("content"),
+		&model.UploadTranslationsRequest{FileID: 8})
+	require.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, upload)
+	assert.Equal(t, []string{"61"}, *deleted)
+}
+
+func TestTranslationsService_UploadTranslationsFromReader_interrupted(t *testing.T) {
+	client, mux, teardown := setupClient()
+	defer teardown()
+
+	deleted := handleStorageLifecycle(t, mux, "content")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mux.HandleFunc("/api/v2/projects/1/translations/fr", func(_ http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		cancel()
+		<-r.Context().Done()
+	})
+
+	upload, _, err := client.Translations.UploadTranslationsFromReader(ctx, 1, "fr", "fr.json", strings.NewReader("content"),
+		&model.UploadTranslationsRequest{FileID: 8})
+	require.ErrorIs(t, err, context.Canceled)
+	require.NotNil(t, upload)
+	assert.Equal(t, 61, upload.StorageID)
+	assert.Empty(t, *deleted, "storage is deleted while the translations may be imported")
+}
@@ -21,11 +21,12 @@ type UsersService struct {
 // GetProjectMember returns information or permissions of a specific project member.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.members.get
-func (s *UsersService) GetProjectMember(ctx context.Context, projectID, memberID int) (
+func (s *UsersService) GetProjectMember(ctx context.Context, projectID, memberID int, reqOpts ...RequestOption) (
 	*model.ProjectMember, *Response, error,
 ) {
+	ctx = withOperation(ctx, "UsersService.GetProjectMember")
 	res := new(model.ProjectMemberResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -33,11 +34,12 @@ func (s *UsersService) GetProjectMember(ctx context.Context, projectID, memberID
 // ListProjectMembers returns a list of project members.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.members.getMany
-func (s *UsersService) ListProjectMembers(ctx context.Context, projectID int, opts *model.ProjectMembersListOptions) (
+func (s *UsersService) ListProjectMembers(ctx context.Context, projectID int, opts *model.ProjectMembersListOptions, reqOpts ...RequestOption) (
 	[]*model.ProjectMember, *Response, error,
 ) {
+	ctx = withOperation(ctx, "UsersService.ListProjectMembers")
 	res := new(model.ProjectMembersListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/members", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/members", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -54,11 +56,12 @@ func (s *UsersService) ListProjectMembers(ctx context.Context, projectID int, op
 // Returns a list of added and skipped members.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.members.post
-func (s *UsersService) AddProjectMember(ctx context.Context, projectID int, req *model.ProjectMemberAddRequest) (
+func (s *UsersService) AddProjectMember(ctx context.Context, projectID int, req *model.ProjectMemberAddRequest, reqOpts ...RequestOption) (
 	map[string][]*model.ProjectMember, *Response, error,
 ) {
+	ctx = withOperation(ctx, "UsersService.AddProjectMember")
 	res := new(model.ProjectMemberAddResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/members", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/members", projectID), req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -84,9 +87,11 @@ func (s *UsersService) AddProjectMember(ctx context.Context, projectID int, req
 // https://developer.crowdin.com/api/v2/#operation/api.projects.members.put
 func (s *UsersService) ReplaceProjectMemberPermissions(
 	ctx context.Context, projectID, memberID int, req *model.ProjectMemberReplaceRequest,
+	reqOpts ...RequestOption,
 ) (*model.ProjectMember, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.ReplaceProjectMemberPermissions")
 	res := new(model.ProjectMemberResponse)
-	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), req, res)
+	resp, err := s.client.Put(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -94,16 +99,18 @@ func (s *UsersService) ReplaceProjectMemberPermissions(
 // DeleteProjectMember removes a member from the project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.members.delete
-func (s *UsersService) DeleteProjectMember(ctx context.Context, projectID, memberID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), nil)
+func (s *UsersService) DeleteProjectMember(ctx context.Context, projectID, memberID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "UsersService.DeleteProjectMember")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/members/%d", projectID, memberID), nil, reqOpts...)
 }
 
 // Get returns information about a specific user.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.getById
-func (s *UsersService) Get(ctx context.Context, userID int) (*model.User, *Response, error) {
+func (s *UsersService) Get(ctx context.Context, userID int, reqOpts ...RequestOption) (*model.User, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.Get")
 	res := new(model.UserResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/users/%d", userID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/users/%d", userID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -111,9 +118,10 @@ func (s *UsersService) Get(ctx context.Context, userID int) (*model.User, *Respo
 // GetAuthenticated returns information about the authenticated user.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.user.get
-func (s *UsersService) GetAuthenticated(ctx context.Context) (*model.User, *Response, error) {
+func (s *UsersService) GetAuthenticated(ctx context.Context, reqOpts ...RequestOption) (*model.User, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.GetAuthenticated")
 	res := new(model.UserResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/user", nil, res)
+	resp, err := s.client.Get(ctx, "/api/v2/user", nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -121,9 +129,10 @@ func (s *UsersService) GetAuthenticated(ctx context.Context) (*model.User, *Resp
 // List returns a list of users in the organization.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.getMany
-func (s *UsersService) List(ctx context.Context, opts *model.UsersListOptions) ([]*model.User, *Response, error) {
+func (s *UsersService) List(ctx context.Context, opts *model.UsersListOptions, reqOpts ...RequestOption) ([]*model.User, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.List")
 	res := new(model.UsersListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/users", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/users", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -139,9 +148,10 @@ func (s *UsersService) List(ctx context.Context, opts *model.UsersListOptions) (
 // Invite sends an invitation to a new user.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.post
-func (s *UsersService) Invite(ctx context.Context, req *model.InviteUserRequest) (*model.User, *Response, error) {
+func (s *UsersService) Invite(ctx context.Context, req *model.InviteUserRequest, reqOpts ...RequestOption) (*model.User, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.Invite")
 	res := new(model.UserResponse)
-	resp, err := s.client.Post(ctx, "/api/v2/users", req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/users", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -155,9 +165,10 @@ func (s *UsersService) Invite(ctx context.Context, req *model.InviteUserRequest)
 //   - value (string): Value to set.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.patch
-func (s *UsersService) Edit(ctx context.Context, userID int, req []*model.UpdateRequest) (*model.User, *Response, error) {
+func (s *UsersService) Edit(ctx context.Context, userID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (*model.User, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.Edit")
 	res := new(model.UserResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/users/%d", userID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/users/%d", userID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -165,18 +176,20 @@ func (s *UsersService) Edit(ctx context.Context, userID int, req []*model.Update
 // Delete removes a user from the organization.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.users.delete
-func (s *UsersService) Delete(ctx context.Context, userID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/users/%d", userID), nil)
+func (s *UsersService) Delete(ctx context.Context, userID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "UsersService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/users/%d", userID), nil, reqOpts...)
 }
 
 // List returns a list of managers.
 //
 // https://support.crowdin.com/developer/enterprise/api/v2/#tag/Users/operation/api.groups.managers.getMany
-func (s *UsersService) ListManagers(ctx context.Context, groupID int, opts *model.ManagerListOptions) ([]*model.Manager, *Response, error) {
+func (s *UsersService) ListManagers(ctx context.Context, groupID int, opts *model.ManagerListOptions, reqOpts ...RequestOption) ([]*model.Manager, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.ListManagers")
 	res := new(model.ManagerResponse)
 	url := fmt.Sprintf("/api/v2/groups/%d/managers", groupID)
 
-	resp, err := s.client.Get(ctx, url, opts, res)
+	resp, err := s.client.Get(ctx, url, opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -192,9 +205,10 @@ func (s *UsersService) ListManagers(ctx context.Context, groupID int, opts *mode
 // Get returns a manager by its identifier.
 //
 // https://support.crowdin.com/developer/enterprise/api/v2/#tag/Users/operation/api.groups.managers.get
-func (s *UsersService) GetManagers(ctx context.Context, groupID int) (*model.Manager, *Response, error) {
+func (s *UsersService) GetManagers(ctx context.Context, groupID int, reqOpts ...RequestOption) (*model.Manager, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.GetManagers")
 	res := new(model.ManagerGetResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/managers", groupID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/groups/%d/managers", groupID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -202,10 +216,11 @@ func (s *UsersService) GetManagers(ctx context.Context, groupID int) (*model.Man
 //	Update a manager.
 //
 // https://support.crowdin.com/developer/enterprise/api/v2/#tag/Users/operation/api.groups.managers.patch
-func (s *UsersService) EditManagers(ctx context.Context, groupID int, req []*model.UpdateRequest) ([]*model.Manager, *Response, error) {
+func (s *UsersService) EditManagers(ctx context.Context, groupID int, req []*model.UpdateRequest, reqOpts ...RequestOption) ([]*model.Manager, *Response, error) {
+	ctx = withOperation(ctx, "UsersService.EditManagers")
 	res := new(model.ManagerResponse)
 	url := fmt.Sprintf("/api/v2/groups/%d/managers", groupID)
-	resp, err := s.client.Patch(ctx, url, req, res)
+	resp, err := s.client.Patch(ctx, url, req, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -20,9 +20,10 @@ type VendorsService struct {
 // List returns a list of vendors.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.vendors.getMany
-func (s *VendorsService) List(ctx context.Context, opt *model.ListOptions) ([]*model.Vendor, *Response, error) {
+func (s *VendorsService) List(ctx context.Context, opt *model.ListOptions, reqOpts ...RequestOption) ([]*model.Vendor, *Response, error) {
+	ctx = withOperation(ctx, "VendorsService.List")
 	res := new(model.VendorsListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/vendors", opt, res)
+	resp, err := s.client.Get(ctx, "/api/v2/vendors", opt, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// errUnsupportedMediaType is returned for the deliveries
+// of a content type the handler cannot decode.
+var errUnsupportedMediaType = errors.New("webhook: unsupported content type")
+
+// ParseValues decodes the payload of a delivery sent as form values or as the
+// query parameters of a GET request. The nested fields of the payload use the
+// bracket notation, e.g. "file[project][id]=1" or "events[0][event]=file.added".
+func ParseValues(values url.Values) ([]Event, error) {
+	data, err := json.Marshal(valuesTree(values))
+	if err != nil {
+		return nil, fmt.Errorf("webhook: invalid payload: %w", err)
+	}
+	return ParseEvents(data)
+}
+
+// readPayload reads the payload of the delivery according to its method and
+// content type, and returns it as JSON.
+func (h *Handler) readPayload(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	if r.Method == http.MethodGet {
+		return json.Marshal(valuesTree(r.URL.Query()))
+	}
+
+	mediaType := "application/json"
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		var err error
+		if mediaType, _, err = mime.ParseMediaType(ct); err != nil {
+			return nil, fmt.Errorf("%w %q", errUnsupportedMediaType, ct)
+		}
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.opts.MaxBodySize)
+	switch mediaType {
+	case "application/json":
+		return io.ReadAll(r.Body)
+	case "application/x-www-form-urlencoded":
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		values, err := url.ParseQuery(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("webhook: invalid form: %w", err)
+		}
+		return json.Marshal(valuesTree(values))
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(h.opts.MaxBodySize); err != nil {
+			return nil, err
+		}
+		defer func() { _ = r.MultipartForm.RemoveAll() }()
+		return json.Marshal(valuesTree(r.MultipartForm.Value))
+	default:
+		return nil, fmt.Errorf("%w %q", errUnsupportedMediaType, mediaType)
+	}
+}
+
+// valuesTree converts the values in bracket notation to nested maps and
+// slices. The maps with numeric keys only, e.g. "labelIds[0]", and the keys
+// ending with "[]" are converted to slices.
+func valuesTree(values url.Values) map[string]any {
+	root := make(map[string]any)
+	for key, vs := range values {
+		path := splitKey(key)
+		if len(vs) == 0 {
+			continue
+		}
+		if path[len(path)-1] == "" {
+			// "key[]" lists the values.
+			for i, v := range vs {
+				setAny(root, append(path[:len(path)-1:len(path)-1], strconv.Itoa(i)), v)
+			}
+			continue
+		}
+		setAny(root, path, vs[0])
+	}
+	for key, v := range root {
+		root[key] = toSlices(v)
+	}
+	return root
+}
+
+// splitKey splits the key in bracket notation, e.g.
+// "file[project][id]" into "file", "project" and "id".
+func splitKey(key string) []string {
+	name, rest, ok := strings.Cut(key, "[")
+	if !ok || !strings.HasSuffix(rest, "]") {
+		return []string{key}
+	}
+	return append([]string{name}, strings.Split(strings.TrimSuffix(rest, "]"), "][")...)
+}
+
+// toSlices converts the maps with numeric keys only to slices ordered by key.
+func toSlices(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	indexes := make([]int, 0, len(m))
+	for key, child := range m {
+		m[key] = toSlices(child)
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 {
+			indexes = append(indexes, i)
+		}
+	}
+	if len(m) == 0 || len(indexes) != len(m) {
+		return m
+	}
+
+	sort.Ints(indexes)
+	s := make([]any, 0, len(indexes))
+	for _, i := range indexes {
+		s = append(s, m[strconv.Itoa(i)])
+	}
+	return s
+}
@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// fileTranslatedValues is the payload of fileTranslatedPayload as form values.
+func fileTranslatedValues() url.Values {
+	return url.Values{
+		"event":                     {"file.translated"},
+		"file[id]":                  {"44"},
+		"file[name]":                {"umbrella_app.xliff"},
+		"file[path]":                {"/directory1/directory2/umbrella_app.xliff"},
+		"file[revision]":            {"10"},
+		"file[branchId]":            {""},
+		"file[project][id]":         {"1"},
+		"file[project][isExternal]": {"0"},
+		"targetLanguage[id]":        {"uk"},
+		"targetLanguage[locale]":    {"uk-UA"},
+	}
+}
+
+func wantFileTranslated() *FileTranslationEvent {
+	return &FileTranslationEvent{
+		Base: Base{model.FileTranslated},
+		File: &File{
+			ID:       44,
+			Name:     "umbrella_app.xliff",
+			Path:     "/directory1/directory2/umbrella_app.xliff",
+			Revision: 10,
+			Project:  &Project{ID: 1},
+		},
+		TargetLanguage: &model.Language{ID: "uk", Locale: "uk-UA"},
+	}
+}
+
+// recordEvents returns a handler recording the events it receives.
+func recordEvents(t *testing.T, opts *Options) (*Handler, *[]Event) {
+	t.Helper()
+
+	var events []Event
+	h, err := NewHandler(opts)
+	require.NoError(t, err)
+	h.OnBatch(func(_ context.Context, batch []Event) error {
+		events = append(events, batch...)
+		return nil
+	})
+	return h, &events
+}
+
+func TestParseValues(t *testing.T) {
+	events, err := ParseValues(fileTranslatedValues())
+	require.NoError(t, err)
+	assert.Equal(t, []Event{wantFileTranslated()}, events)
+
+	events, err = ParseValues(url.Values{
+		"events[1][event]":              {"string.added"},
+		"events[1][string][id]":         {"2"},
+		"events[0][event]":              {"string.updated"},
+		"events[0][string][id]":         {"1"},
+		"events[0][string][labelIds][]": {"3", "4"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Event{
+		&StringEvent{Base: Base{model.StringUpdated}, String: &String{ID: 1, LabelIDs: []Int{3, 4}}},
+		&StringEvent{Base: Base{model.StringAdded}, String: &String{ID: 2}},
+	}, events)
+}
+
+func TestHandler_contentTypes(t *testing.T) {
+	form := fileTranslatedValues().Encode()
+
+	var multipartBody bytes.Buffer
+	mw := multipart.NewWriter(&multipartBody)
+	for key, values := range fileTranslatedValues() {
+		require.NoError(t, mw.WriteField(key, values[0]))
+	}
+	require.NoError(t, mw.Close())
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+	}{
+		{"json", http.MethodPost, "/", "application/json; charset=utf-8", fileTranslatedPayload},
+		{"no content type", http.MethodPost, "/", "", fileTranslatedPayload},
+		{"form", http.MethodPost, "/", "application/x-www-form-urlencoded", form},
+		{"multipart", http.MethodPost, "/", mw.FormDataContentType(), multipartBody.String()},
+		{"get", http.MethodGet, "/?" + form, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, events := recordEvents(t, nil)
+
+			r := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			require.Len(t, *events, 1)
+			e := (*events)[0]
+			if tt.name == "json" || tt.name == "no content type" {
+				// The JSON payload has more fields.
+				ft, ok := e.(*FileTranslationEvent)
+				require.True(t, ok)
+				assert.Equal(t, Int(44), ft.File.ID)
+				return
+			}
+			assert.Equal(t, wantFileTranslated(), e)
+		})
+	}
+}
+
+func TestHandler_contentTypeErrors(t *testing.T) {
+	h, _ := recordEvents(t, &Options{MaxBodySize: 64})
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		code        int
+	}{
+		{"unsupported", "text/plain", "event=file.added", http.StatusUnsupportedMediaType},
+		{"invalid content type", "application/", "{}", http.StatusUnsupportedMediaType},
+		{"invalid form", "application/x-www-form-urlencoded", "event=%zz", http.StatusBadRequest},
+		{"too large form", "application/x-www-form-urlencoded", "event=file.added&x=" + strings.Repeat("x", 64), http.StatusRequestEntityTooLarge},
+		{"invalid multipart", "multipart/form-data; boundary=x", "event", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+}
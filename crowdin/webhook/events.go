@@ -0,0 +1,353 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// Event is an event delivered by a webhook.
+type Event interface {
+	// EventName returns the name of the event, e.g. "file.translated".
+	EventName() model.Event
+}
+
+// Base holds the name of the event. It is embedded in all the events.
+type Base struct {
+	Event model.Event `json:"event"`
+}
+
+// EventName returns the name of the event.
+func (b *Base) EventName() model.Event {
+	return b.Event
+}
+
+// FileEvent is delivered when a file is added, updated, reverted or deleted.
+type FileEvent struct {
+	Base
+	File *File `json:"file"`
+	User *User `json:"user"`
+}
+
+// FileTranslationEvent is delivered when a file is fully translated or approved.
+type FileTranslationEvent struct {
+	Base
+	File           *File           `json:"file"`
+	TargetLanguage *model.Language `json:"targetLanguage"`
+}
+
+// ProjectTranslationEvent is delivered when all the strings
+// of a project are translated or approved.
+type ProjectTranslationEvent struct {
+	Base
+	Project        *Project        `json:"project"`
+	TargetLanguage *model.Language `json:"targetLanguage"`
+}
+
+// ProjectBuiltEvent is delivered when a project is built.
+type ProjectBuiltEvent struct {
+	Base
+	Build *Build `json:"build"`
+}
+
+// ProjectEvent is delivered when a project is created or deleted.
+// It is an event of the organization webhooks.
+type ProjectEvent struct {
+	Base
+	Project *Project `json:"project"`
+	User    *User    `json:"user"`
+}
+
+// TranslationUpdatedEvent is delivered when the final translation of a string
+// is updated.
+type TranslationUpdatedEvent struct {
+	Base
+	OldTranslation *Translation `json:"oldTranslation"`
+	NewTranslation *Translation `json:"newTranslation"`
+}
+
+// StringEvent is delivered when a source string is added, updated or deleted.
+type StringEvent struct {
+	Base
+	String *String `json:"string"`
+	User   *User   `json:"user"`
+}
+
+// StringCommentEvent is delivered when a string comment or issue is created,
+// updated, deleted or restored.
+type StringCommentEvent struct {
+	Base
+	Comment *Comment `json:"comment"`
+}
+
+// SuggestionEvent is delivered when a translation is added, updated, deleted,
+// approved or disapproved.
+type SuggestionEvent struct {
+	Base
+	Translation *Translation `json:"translation"`
+}
+
+// TaskEvent is delivered when a task is added, deleted or its status changes.
+type TaskEvent struct {
+	Base
+	Task *Task `json:"task"`
+}
+
+// UnknownEvent is an event the package has no type for.
+// Data holds the payload of the event.
+type UnknownEvent struct {
+	Base
+	Data json.RawMessage `json:"-"`
+}
+
+// Project is a project in the payload of an event.
+type Project struct {
+	ID                Int      `json:"id"`
+	UserID            Int      `json:"userId"`
+	SourceLanguageID  string   `json:"sourceLanguageId"`
+	TargetLanguageIDs []string `json:"targetLanguageIds"`
+	Identifier        string   `json:"identifier"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description"`
+	Logo              string   `json:"logo"`
+	Background        string   `json:"background"`
+	IsExternal        Bool     `json:"isExternal"`
+	ExternalType      string   `json:"externalType"`
+	HasCrowdsourcing  Bool     `json:"hasCrowdsourcing"`
+	LastActivity      string   `json:"lastActivity"`
+	CreatedAt         string   `json:"createdAt"`
+	UpdatedAt         string   `json:"updatedAt"`
+}
+
+// File is a file in the payload of an event.
+type File struct {
+	ID          Int      `json:"id"`
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	Type        string   `json:"type"`
+	Path        string   `json:"path"`
+	Status      string   `json:"status"`
+	Revision    Int      `json:"revision"`
+	BranchID    Int      `json:"branchId"`
+	DirectoryID Int      `json:"directoryId"`
+	Project     *Project `json:"project"`
+}
+
+// User is a user in the payload of an event.
+type User struct {
+	ID        Int    `json:"id"`
+	Username  string `json:"username"`
+	FullName  string `json:"fullName"`
+	AvatarURL string `json:"avatarUrl"`
+}
+
+// Build is a build of a project in the payload of an event.
+type Build struct {
+	ID           Int      `json:"id"`
+	DownloadLink string   `json:"downloadLink"`
+	Project      *Project `json:"project"`
+}
+
+// String is a source string in the payload of an event.
+type String struct {
+	ID             Int      `json:"id"`
+	Identifier     string   `json:"identifier"`
+	Key            string   `json:"key"`
+	Text           any      `json:"text"`
+	Type           string   `json:"type"`
+	Context        string   `json:"context"`
+	MaxLength      Int      `json:"maxLength"`
+	IsHidden       Bool     `json:"isHidden"`
+	IsDuplicate    Bool     `json:"isDuplicate"`
+	MasterStringID Int      `json:"masterStringId"`
+	Revision       Int      `json:"revision"`
+	HasPlurals     Bool     `json:"hasPlurals"`
+	LabelIDs       []Int    `json:"labelIds"`
+	URL            string   `json:"url"`
+	CreatedAt      string   `json:"createdAt"`
+	UpdatedAt      string   `json:"updatedAt"`
+	File           *File    `json:"file"`
+	Project        *Project `json:"project"`
+}
+
+// Translation is a translation of a string in the payload of an event.
+type Translation struct {
+	ID              Int             `json:"id"`
+	Text            string          `json:"text"`
+	PluralCategory  string          `json:"pluralCategory"`
+	Rating          Int             `json:"rating"`
+	Provider        string          `json:"provider"`
+	IsPreTranslated Bool            `json:"isPreTranslated"`
+	CreatedAt       string          `json:"createdAt"`
+	UpdatedAt       string          `json:"updatedAt"`
+	String          *String         `json:"string"`
+	TargetLanguage  *model.Language `json:"targetLanguage"`
+	User            *User           `json:"user"`
+}
+
+// Comment is a string comment or issue in the payload of an event.
+type Comment struct {
+	ID             Int             `json:"id"`
+	Text           string          `json:"text"`
+	Type           string          `json:"type"`
+	IssueType      string          `json:"issueType"`
+	IssueStatus    string          `json:"issueStatus"`
+	ResolvedAt     string          `json:"resolvedAt"`
+	CreatedAt      string          `json:"createdAt"`
+	String         *String         `json:"string"`
+	TargetLanguage *model.Language `json:"targetLanguage"`
+	User           *User           `json:"user"`
+}
+
+// Task is a task in the payload of an event.
+type Task struct {
+	ID             Int             `json:"id"`
+	Type           string          `json:"type"`
+	Vendor         string          `json:"vendor"`
+	Status         string          `json:"status"`
+	OldStatus      string          `json:"oldStatus"`
+	NewStatus      string          `json:"newStatus"`
+	Title          string          `json:"title"`
+	Description    string          `json:"description"`
+	WordsCount     Int             `json:"wordsCount"`
+	FilesCount     Int             `json:"filesCount"`
+	Deadline       string          `json:"deadline"`
+	CreatedAt      string          `json:"createdAt"`
+	UpdatedAt      string          `json:"updatedAt"`
+	SourceLanguage *model.Language `json:"sourceLanguage"`
+	TargetLanguage *model.Language `json:"targetLanguage"`
+	Project        *Project        `json:"project"`
+	TaskCreator    *User           `json:"taskCreator"`
+}
+
+// Int is an integer decoded from a JSON number or string.
+// Crowdin sends the numbers of the payloads as strings.
+type Int int
+
+// UnmarshalJSON decodes a number, a string or null.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	s := string(bytes.Trim(data, `"`))
+	if s == "" || s == "null" {
+		*i = 0
+		return nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("webhook: invalid integer %s", data)
+	}
+	*i = Int(n)
+	return nil
+}
+
+// Bool is a boolean decoded from a JSON boolean, number or string.
+type Bool bool
+
+// UnmarshalJSON decodes a boolean, "true", "false", 1, 0 or null.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	switch s := string(bytes.Trim(data, `"`)); s {
+	case "true", "1":
+		*b = true
+	case "false", "0", "", "null":
+		*b = false
+	default:
+		return fmt.Errorf("webhook: invalid boolean %s", data)
+	}
+	return nil
+}
+
+// newEvent returns the event type of the name,
+// or nil if the package has no type for it.
+func newEvent(name model.Event) Event {
+	switch name {
+	case model.FileAdded, model.FileUpdated, model.FileReverted, model.FileDeleted:
+		return &FileEvent{}
+	case model.FileTranslated, model.FileApproved:
+		return &FileTranslationEvent{}
+	case model.ProjectTranslated, model.ProjectApproved:
+		return &ProjectTranslationEvent{}
+	case model.ProjectBuilt:
+		return &ProjectBuiltEvent{}
+	case model.ProjectCreated, model.ProjectDeleted:
+		return &ProjectEvent{}
+	case model.TranslationUpdated:
+		return &TranslationUpdatedEvent{}
+	case model.StringAdded, model.StringUpdated, model.StringDeleted:
+		return &StringEvent{}
+	case model.StringCommentCreated, model.StringCommentUpdated, model.StringCommentDeleted, model.StringCommentRestored:
+		return &StringCommentEvent{}
+	case model.SuggestionAdded, model.SuggestionUpdated, model.SuggestionDeleted, model.SuggestionApproved, model.SuggestionDisapproved:
+		return &SuggestionEvent{}
+	case model.TaskAdded, model.TaskStatusChanged, model.TaskDeleted:
+		return &TaskEvent{}
+	default:
+		return nil
+	}
+}
+
+// eventNames returns the names of the events the package has a type for.
+func eventNames() []model.Event {
+	return []model.Event{
+		model.FileAdded, model.FileUpdated, model.FileReverted, model.FileDeleted,
+		model.FileTranslated, model.FileApproved,
+		model.ProjectTranslated, model.ProjectApproved, model.ProjectBuilt,
+		model.ProjectCreated, model.ProjectDeleted,
+		model.TranslationUpdated,
+		model.StringAdded, model.StringUpdated, model.StringDeleted,
+		model.StringCommentCreated, model.StringCommentUpdated, model.StringCommentDeleted, model.StringCommentRestored,
+		model.SuggestionAdded, model.SuggestionUpdated, model.SuggestionDeleted, model.SuggestionApproved, model.SuggestionDisapproved,
+		model.TaskAdded, model.TaskStatusChanged, model.TaskDeleted,
+	}
+}
+
+// Parse decodes the JSON payload of an event. The events the package has
+// no type for are returned as *UnknownEvent.
+func Parse(data []byte) (Event, error) {
+	var base Base
+	if err := json.Unmarshal(data, &base); err != nil {
+		return nil, fmt.Errorf("webhook: invalid payload: %w", err)
+	}
+	if base.Event == "" {
+		return nil, ErrNoEvent
+	}
+
+	e := newEvent(base.Event)
+	if e == nil {
+		return &UnknownEvent{Base: base, Data: data}, nil
+	}
+	if err := json.Unmarshal(data, e); err != nil {
+		return nil, fmt.Errorf("webhook: invalid %s payload: %w", base.Event, err)
+	}
+	return e, nil
+}
+
+// ParseEvents decodes the JSON payload of a delivery, which holds either one
+// event or, for the webhooks with batching enabled, an "events" array of
+// events. The events are returned in the order of the delivery.
+func ParseEvents(data []byte) ([]Event, error) {
+	var batch struct {
+		Events []json.RawMessage `json:"events"`
+	}
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return nil, fmt.Errorf("webhook: invalid payload: %w", err)
+	}
+	if batch.Events == nil {
+		e, err := Parse(data)
+		if err != nil {
+			return nil, err
+		}
+		return []Event{e}, nil
+	}
+
+	events := make([]Event, 0, len(batch.Events))
+	for i, raw := range batch.Events {
+		e, err := Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w (events[%d])", err, i)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
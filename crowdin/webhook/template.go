@@ -0,0 +1,386 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// variables matches the variables of the payload templates, e.g. "{{fileId}}".
+var variables = regexp.MustCompile(`{{\s*([A-Za-z0-9_.]+)\s*}}`)
+
+// parseTemplates decodes the custom payload templates declared in
+// WebhookAddRequest.Payload, a JSON object keyed by event names, e.g.
+//
+//	{"file.translated": {"id": "{{fileId}}", "language": "{{targetLanguageId}}"}}
+func parseTemplates(payload any) (map[model.Event]any, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[model.Event]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("payload must be an object keyed by event names: %w", err)
+	}
+	templates := make(map[model.Event]any, len(raw))
+	for name, t := range raw {
+		if templates[name], err = decodeJSON(t); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkAmbiguous(templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// checkAmbiguous reports the templates without an "event" key that a payload
+// rendered from the template of another event could match, as the handler
+// could not tell their events apart.
+func checkAmbiguous(templates map[model.Event]any) error {
+	var names []string
+	for name, t := range templates {
+		if m, ok := t.(map[string]any); ok {
+			if _, ok := m["event"].(string); ok {
+				continue
+			}
+		}
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	for i, a := range names {
+		for _, b := range names[i+1:] {
+			ta, tb := templates[model.Event(a)], templates[model.Event(b)]
+			if overlap(ta, tb) || overlap(tb, ta) {
+				return fmt.Errorf(`the templates of %s and %s are ambiguous, add an "event": "{{event}}" key to them`, a, b)
+			}
+		}
+	}
+	return nil
+}
+
+// overlap reports whether a payload rendered from the template u
+// could match the template t.
+func overlap(t, u any) bool {
+	if isVariable(t) || isVariable(u) {
+		return true
+	}
+
+	switch t := t.(type) {
+	case map[string]any:
+		m, ok := u.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, child := range t {
+			value, ok := m[key]
+			if !ok || !overlap(child, value) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		s, ok := u.([]any)
+		if !ok || len(s) != len(t) {
+			return false
+		}
+		for i := range t {
+			if !overlap(t[i], s[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		s, ok := u.(string)
+		if !ok {
+			return matchString(t, u, make(map[string]any))
+		}
+		return overlapStrings(t, s)
+	default:
+		return fmt.Sprint(t) == fmt.Sprint(u)
+	}
+}
+
+// overlapStrings reports whether a string could match both templates. The
+// templates with variables are compared by their text around the variables.
+func overlapStrings(t, u string) bool {
+	tv, uv := variables.FindAllStringIndex(t, -1), variables.FindAllStringIndex(u, -1)
+	switch {
+	case len(tv) == 0 && len(uv) == 0:
+		return t == u
+	case len(uv) == 0:
+		return matchString(t, u, make(map[string]any))
+	case len(tv) == 0:
+		return matchString(u, t, make(map[string]any))
+	}
+
+	tPrefix, tSuffix := t[:tv[0][0]], t[tv[len(tv)-1][1]:]
+	uPrefix, uSuffix := u[:uv[0][0]], u[uv[len(uv)-1][1]:]
+	return (strings.HasPrefix(tPrefix, uPrefix) || strings.HasPrefix(uPrefix, tPrefix)) &&
+		(strings.HasSuffix(tSuffix, uSuffix) || strings.HasSuffix(uSuffix, tSuffix))
+}
+
+// isVariable reports whether the template is a single variable, matching any value.
+func isVariable(t any) bool {
+	s, ok := t.(string)
+	if !ok {
+		return false
+	}
+	loc := variables.FindStringIndex(s)
+	return loc != nil && loc[0] == 0 && loc[1] == len(s)
+}
+
+// applyTemplates converts the payloads of the delivery rendered from custom
+// templates to the default payloads of their events.
+func (h *Handler) applyTemplates(data []byte) ([]byte, error) {
+	v, err := decodeJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: invalid payload: %w", err)
+	}
+
+	if m, ok := v.(map[string]any); ok {
+		if batch, ok := m["events"].([]any); ok {
+			for i, e := range batch {
+				batch[i] = h.applyTemplate(e)
+			}
+			return json.Marshal(m)
+		}
+	}
+	return json.Marshal(h.applyTemplate(v))
+}
+
+// applyTemplate converts a payload rendered from the template of its event.
+// If the payload has no event name, the template it matches is used, which is
+// unique as parseTemplates rejects the ambiguous templates. The other payloads
+// are left unchanged.
+func (h *Handler) applyTemplate(v any) any {
+	m, _ := v.(map[string]any)
+	if name, ok := m["event"].(string); ok {
+		if t, ok := h.templates[model.Event(name)]; ok {
+			if out, ok := fromTemplate(model.Event(name), t, v); ok {
+				return out
+			}
+		}
+		return v
+	}
+
+	names := make([]string, 0, len(h.templates))
+	for name := range h.templates {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if out, ok := fromTemplate(model.Event(name), h.templates[model.Event(name)], v); ok {
+			return out
+		}
+	}
+	return v
+}
+
+// fromTemplate extracts the variables of the template from the payload and
+// returns the default payload of the event holding them.
+func fromTemplate(name model.Event, t, v any) (any, bool) {
+	vars := make(map[string]any)
+	if !matchTemplate(t, v, vars) {
+		return nil, false
+	}
+
+	e := newEvent(name)
+	if e == nil {
+		// The package has no type for the event, keep the payload as is.
+		if m, ok := v.(map[string]any); ok {
+			m["event"] = string(name)
+		}
+		return v, true
+	}
+
+	out := map[string]any{"event": string(name)}
+	for variable, value := range vars {
+		if path := fieldPath(reflect.TypeOf(e), variable); path != nil {
+			setAny(out, path, value)
+		}
+	}
+	return out, true
+}
+
+// matchTemplate reports whether the payload matches the template,
+// and collects the values of the variables of the template.
+func matchTemplate(t, v any, vars map[string]any) bool {
+	switch t := t.(type) {
+	case map[string]any:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return false
+		}
+		for key, child := range t {
+			value, ok := m[key]
+			if !ok || !matchTemplate(child, value, vars) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		s, ok := v.([]any)
+		if !ok || len(s) != len(t) {
+			return false
+		}
+		for i := range t {
+			if !matchTemplate(t[i], s[i], vars) {
+				return false
+			}
+		}
+		return true
+	case string:
+		return matchString(t, v, vars)
+	default:
+		return fmt.Sprint(t) == fmt.Sprint(v)
+	}
+}
+
+// matchString matches a string of the template, e.g. "{{fileName}} ({{fileId}})".
+// A variable making up the whole string matches any value.
+func matchString(t string, v any, vars map[string]any) bool {
+	locs := variables.FindAllStringSubmatchIndex(t, -1)
+	if len(locs) == 1 && locs[0][0] == 0 && locs[0][1] == len(t) {
+		vars[t[locs[0][2]:locs[0][3]]] = v
+		return true
+	}
+
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	default:
+		return false
+	}
+	if len(locs) == 0 {
+		return s == t
+	}
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	names := make([]string, 0, len(locs))
+	last := 0
+	for _, loc := range locs {
+		pattern.WriteString(regexp.QuoteMeta(t[last:loc[0]]))
+		pattern.WriteString("(.*?)")
+		names = append(names, t[loc[2]:loc[3]])
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(t[last:]) + "$")
+
+	re, err := regexp.Compile("(?s)" + pattern.String())
+	if err != nil {
+		return false
+	}
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return false
+	}
+	for i, name := range names {
+		vars[name] = match[i+1]
+	}
+	return true
+}
+
+// fieldPath returns the JSON path of the field of the event named by the
+// variable. A variable is either a dotted path, e.g. "file.project.id", or the
+// camel case concatenation of the end of a path, e.g. "fileId" or "projectId"
+// for "file.project.id". The shortest path matching the variable is used.
+func fieldPath(t reflect.Type, variable string) []string {
+	if strings.Contains(variable, ".") {
+		return strings.Split(variable, ".")
+	}
+
+	var best []string
+	walkFields(t, nil, func(path []string) {
+		if best != nil && len(path) >= len(best) {
+			return
+		}
+		for i := range path {
+			if camelCase(path[i:]) == variable {
+				best = append([]string(nil), path...)
+				return
+			}
+		}
+	})
+	return best
+}
+
+// walkFields calls fn with the JSON paths of the fields of the struct type,
+// in the order of the fields, the nested structs being walked through.
+func walkFields(t reflect.Type, path []string, fn func(path []string)) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if f.Anonymous {
+			walkFields(f.Type, path, fn)
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		p := append(path[:len(path):len(path)], name)
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Struct {
+			walkFields(ft, p, fn)
+			continue
+		}
+		fn(p)
+	}
+}
+
+// camelCase joins the names, e.g. "targetLanguage" and "id" into "targetLanguageId".
+func camelCase(names []string) string {
+	var b strings.Builder
+	for i, name := range names {
+		if i > 0 && name != "" {
+			name = strings.ToUpper(name[:1]) + name[1:]
+		}
+		b.WriteString(name)
+	}
+	return b.String()
+}
+
+func setAny(m map[string]any, path []string, v any) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := m[key].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			m[key] = next
+		}
+		m = next
+	}
+	m[path[len(path)-1]] = v
+}
+
+// decodeJSON decodes the JSON data keeping the numbers as json.Number.
+func decodeJSON(data []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
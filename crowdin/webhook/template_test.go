@@ -0,0 +1,209 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+func templates() map[string]any {
+	return map[string]any{
+		"file.translated": map[string]any{
+			"event":     "{{event}}",
+			"project":   "{{projectName}}",
+			"projectId": "{{projectId}}",
+			"language":  "{{targetLanguageId}}",
+			"fileId":    "{{fileId}}",
+			"file":      "{{fileName}}",
+		},
+		"suggestion.approved": map[string]any{
+			"type":    "approval",
+			"message": "{{translationText}} ({{translation.string.id}})",
+			"user":    map[string]any{"name": "{{userUsername}}"},
+		},
+	}
+}
+
+func TestHandler_payloadTemplates(t *testing.T) {
+	h, events := recordEvents(t, &Options{Payload: templates()})
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        Event
+	}{
+		{
+			"json",
+			"application/json",
+			`{"event": "file.translated", "project": "Umbrella", "projectId": 1, "language": "uk", "fileId": "44", "file": "app.xliff"}`,
+			&FileTranslationEvent{
+				Base:           Base{model.FileTranslated},
+				File:           &File{ID: 44, Name: "app.xliff", Project: &Project{ID: 1, Name: "Umbrella"}},
+				TargetLanguage: &model.Language{ID: "uk"},
+			},
+		},
+		{
+			"form",
+			"application/x-www-form-urlencoded",
+			url.Values{"event": {"file.translated"}, "project": {"Umbrella"}, "projectId": {"1"}, "language": {"uk"}, "fileId": {"44"}, "file": {"app.xliff"}}.Encode(),
+			&FileTranslationEvent{
+				Base:           Base{model.FileTranslated},
+				File:           &File{ID: 44, Name: "app.xliff", Project: &Project{ID: 1, Name: "Umbrella"}},
+				TargetLanguage: &model.Language{ID: "uk"},
+			},
+		},
+		{
+			"no event name",
+			"application/json",
+			`{"type": "approval", "message": "Привіт (12)", "user": {"name": "john"}}`,
+			&SuggestionEvent{
+				Base: Base{model.SuggestionApproved},
+				Translation: &Translation{
+					Text:   "Привіт",
+					String: &String{ID: 12},
+					User:   &User{Username: "john"},
+				},
+			},
+		},
+		{
+			"default payload",
+			"application/json",
+			`{"event": "suggestion.approved", "translation": {"id": "5"}}`,
+			&SuggestionEvent{Base: Base{model.SuggestionApproved}, Translation: &Translation{ID: 5}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*events = nil
+
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, []Event{tt.want}, *events)
+		})
+	}
+}
+
+func TestHandler_payloadTemplates_batch(t *testing.T) {
+	h, events := recordEvents(t, &Options{Payload: templates()})
+
+	w := deliver(h, http.MethodPost, `{"events": [
+		{"type": "approval", "message": "Hi (1)", "user": {"name": "ann"}},
+		{"event": "file.translated", "project": "Umbrella", "projectId": "1", "language": "fr", "fileId": "2", "file": "a.json"}
+	]}`)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	require.Len(t, *events, 2)
+	assert.Equal(t, model.SuggestionApproved, (*events)[0].EventName())
+	assert.Equal(t, model.FileTranslated, (*events)[1].EventName())
+}
+
+func TestHandler_payloadTemplates_noMatch(t *testing.T) {
+	h, _ := recordEvents(t, &Options{Payload: templates()})
+
+	w := deliver(h, http.MethodPost, `{"type": "rejection", "message": "Hi (1)", "user": {"name": "ann"}}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestNewHandler_invalidPayload(t *testing.T) {
+	h, err := NewHandler(&Options{Payload: []string{"file.translated"}})
+	require.ErrorContains(t, err, "webhook: invalid payload templates: payload must be an object keyed by event names")
+	assert.Nil(t, h)
+}
+
+func TestNewHandler_ambiguousPayload(t *testing.T) {
+	tests := []struct {
+		name      string
+		payload   map[string]any
+		ambiguous bool
+	}{
+		{"same shape", map[string]any{
+			"file.translated": map[string]any{"id": "{{fileId}}"},
+			"file.approved":   map[string]any{"id": "{{fileId}}"},
+		}, true},
+		{"subset", map[string]any{
+			"file.translated": map[string]any{"id": "{{fileId}}"},
+			"file.approved":   map[string]any{"id": "{{fileId}}", "user": "{{userUsername}}"},
+		}, true},
+		{"same prefix", map[string]any{
+			"file.translated": map[string]any{"message": "file {{fileId}}"},
+			"file.approved":   map[string]any{"message": "file {{fileId}} by {{userUsername}}"},
+		}, true},
+		{"event names", map[string]any{
+			"file.translated": map[string]any{"event": "{{event}}", "id": "{{fileId}}"},
+			"file.approved":   map[string]any{"event": "{{event}}", "id": "{{fileId}}"},
+		}, false},
+		{"literals", map[string]any{
+			"file.translated": map[string]any{"type": "translated", "id": "{{fileId}}"},
+			"file.approved":   map[string]any{"type": "approved", "id": "{{fileId}}"},
+		}, false},
+		{"text", map[string]any{
+			"file.translated": map[string]any{"message": "translated {{fileId}}"},
+			"file.approved":   map[string]any{"message": "approved {{fileId}}"},
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewHandler(&Options{Payload: tt.payload})
+			if tt.ambiguous {
+				assert.EqualError(t, err, `webhook: invalid payload templates: the templates of file.approved `+
+					`and file.translated are ambiguous, add an "event": "{{event}}" key to them`)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHandler_payloadTemplates_sameShape(t *testing.T) {
+	h, events := recordEvents(t, &Options{Payload: map[string]any{
+		"file.translated": map[string]any{"event": "{{event}}", "id": "{{fileId}}"},
+		"file.approved":   map[string]any{"event": "{{event}}", "id": "{{fileId}}"},
+	}})
+
+	for _, name := range []model.Event{model.FileTranslated, model.FileApproved} {
+		*events = nil
+		w := deliver(h, http.MethodPost, `{"event": "`+string(name)+`", "id": "44"}`)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, *events, 1)
+		assert.Equal(t, name, (*events)[0].EventName())
+	}
+}
+
+func TestFieldPath(t *testing.T) {
+	tests := []struct {
+		event    Event
+		variable string
+		want     []string
+	}{
+		{&FileTranslationEvent{}, "fileId", []string{"file", "id"}},
+		{&FileTranslationEvent{}, "projectId", []string{"file", "project", "id"}},
+		{&FileTranslationEvent{}, "targetLanguageId", []string{"targetLanguage", "id"}},
+		{&FileTranslationEvent{}, "id", []string{"file", "id"}},
+		{&StringEvent{}, "projectId", []string{"string", "project", "id"}},
+		{&SuggestionEvent{}, "fileName", []string{"translation", "string", "file", "name"}},
+		{&ProjectBuiltEvent{}, "buildDownloadLink", []string{"build", "downloadLink"}},
+		{&TaskEvent{}, "task.newStatus", []string{"task", "newStatus"}},
+		{&TaskEvent{}, "unknown", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.variable, func(t *testing.T) {
+			assert.Equal(t, tt.want, fieldPath(reflect.TypeOf(tt.event), tt.variable))
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"net/netip"
+	"time"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// DefaultSecretHeader is the default header holding the shared secret.
+const DefaultSecretHeader = "X-Crowdin-Webhook-Secret"
+
+// Errors of the verification of the deliveries.
+var (
+	ErrInvalidSecret    = errors.New("webhook: invalid secret")
+	ErrInvalidTimestamp = errors.New("webhook: invalid timestamp")
+	ErrStaleDelivery    = errors.New("webhook: stale delivery")
+	ErrIPNotAllowed     = errors.New("webhook: IP address not allowed")
+)
+
+// Verifier verifies the authenticity of the deliveries.
+//
+// Crowdin sends the headers declared in the Headers of the webhook with each
+// delivery, so a secret shared through a header proves that the delivery
+// comes from the webhook. The secret is compared in constant time.
+//
+// The headers are static and Crowdin does not sign the deliveries, so the
+// secret does not protect against the replay of a captured delivery. Only
+// MaxAge with an authenticated Timestamp does.
+type Verifier struct {
+	// Secret is the secret the header must hold.
+	// If empty, the secret is not verified.
+	Secret string
+	// Header is the header holding the secret.
+	// Defaults to DefaultSecretHeader.
+	Header string
+
+	// MaxAge is the maximum difference between the time of the delivery and
+	// the current time. Older deliveries are rejected as replays.
+	// If zero, the time of the deliveries is not verified.
+	MaxAge time.Duration
+	// Timestamp returns the time of the delivery, and is required with
+	// MaxAge. Crowdin sends no time with the deliveries, so the time must
+	// be authenticated, e.g. signed along with the body by a trusted proxy
+	// in front of the handler; a time read from an unsigned header can be
+	// forged along with the replayed delivery.
+	Timestamp func(r *http.Request) (time.Time, error)
+
+	// AllowIP reports whether the deliveries from the IP address are
+	// allowed, e.g. AllowPrefixes(prefixes...). If nil, all the addresses
+	// are allowed.
+	AllowIP func(ip netip.Addr) bool
+	// ClientIP returns the IP address of the client, e.g. from the
+	// X-Forwarded-For header of a trusted proxy. Defaults to the address
+	// of the remote end of the connection.
+	ClientIP func(r *http.Request) (netip.Addr, error)
+
+	now func() time.Time
+}
+
+// Verify checks the IP address, the secret and the time of the delivery.
+// The errors match ErrIPNotAllowed, ErrInvalidSecret, ErrInvalidTimestamp
+// and ErrStaleDelivery with errors.Is.
+func (v *Verifier) Verify(r *http.Request) error {
+	if v.AllowIP != nil {
+		clientIP := v.ClientIP
+		if clientIP == nil {
+			clientIP = remoteIP
+		}
+		ip, err := clientIP(r)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrIPNotAllowed, err)
+		}
+		if !v.AllowIP(ip) {
+			return fmt.Errorf("%w: %s", ErrIPNotAllowed, ip)
+		}
+	}
+
+	if v.Secret != "" {
+		header := v.Header
+		if header == "" {
+			header = DefaultSecretHeader
+		}
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(header)), []byte(v.Secret)) != 1 {
+			return ErrInvalidSecret
+		}
+	}
+
+	if v.MaxAge > 0 {
+		if v.Timestamp == nil {
+			return fmt.Errorf("%w: MaxAge requires Timestamp", ErrInvalidTimestamp)
+		}
+		t, err := v.Timestamp(r)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidTimestamp, err)
+		}
+		now := time.Now
+		if v.now != nil {
+			now = v.now
+		}
+		if age := now().Sub(t); age > v.MaxAge || age < -v.MaxAge {
+			return fmt.Errorf("%w: sent at %s", ErrStaleDelivery, t.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// Middleware returns a handler calling next with the verified deliveries,
+// and rejecting the other ones with 403 Forbidden for the IP addresses not
+// allowed or 401 Unauthorized.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := v.Verify(r); err != nil {
+			code := verifyStatus(err)
+			http.Error(w, http.StatusText(code), code)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifyStatus returns the status code of the response to
+// the deliveries failing the verification.
+func verifyStatus(err error) int {
+	if errors.Is(err, ErrIPNotAllowed) {
+		return http.StatusForbidden
+	}
+	return http.StatusUnauthorized
+}
+
+// AllowPrefixes returns a function allowing the IP
+// addresses in the prefixes, e.g. "192.0.2.0/24".
+func AllowPrefixes(prefixes ...netip.Prefix) func(ip netip.Addr) bool {
+	return func(ip netip.Addr) bool {
+		ip = ip.Unmap()
+		for _, p := range prefixes {
+			if p.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// WithSecret returns a copy of the request with a new random secret in the
+// header, and the secret. If header is empty, DefaultSecretHeader is used.
+func WithSecret(req *model.WebhookAddRequest, header string) (*model.WebhookAddRequest, string, error) {
+	if req == nil {
+		return nil, "", errors.New("webhook: request cannot be nil")
+	}
+	if header == "" {
+		header = DefaultSecretHeader
+	}
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, "", err
+	}
+
+	r := *req
+	r.Headers = maps.Clone(req.Headers)
+	if r.Headers == nil {
+		r.Headers = make(map[string]string)
+	}
+	r.Headers[header] = secret
+	return &r, secret, nil
+}
+
+// AddWithSecret creates the webhook of the project with a new random secret
+// in DefaultSecretHeader, and returns the secret to verify its deliveries.
+func AddWithSecret(ctx context.Context, s *crowdin.WebhooksService, projectID int, req *model.WebhookAddRequest,
+	reqOpts ...crowdin.RequestOption,
+) (*model.Webhook, string, error) {
+	r, secret, err := WithSecret(req, "")
+	if err != nil {
+		return nil, "", err
+	}
+	hook, _, err := s.Add(ctx, projectID, r, reqOpts...)
+	if err != nil {
+		return nil, "", err
+	}
+	return hook, secret, nil
+}
+
+// GenerateSecret returns a random secret of 256 bits, hex encoded.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("webhook: cannot generate secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func remoteIP(r *http.Request) (netip.Addr, error) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return netip.ParseAddr(host)
+}
@@ -0,0 +1,242 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// timestampHeader stands for the time of the delivery
+// authenticated by a signing proxy.
+const timestampHeader = "X-Proxy-Timestamp"
+
+func TestVerifier_Verify(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	v := &Verifier{
+		Secret: "s3cret",
+		MaxAge: 5 * time.Minute,
+		Timestamp: func(r *http.Request) (time.Time, error) {
+			sec, err := strconv.ParseInt(r.Header.Get(timestampHeader), 10, 64)
+			return time.Unix(sec, 0), err
+		},
+		AllowIP: AllowPrefixes(netip.MustParsePrefix("192.0.2.0/24")),
+		now:     func() time.Time { return now },
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		err        error
+	}{
+		{"valid", "192.0.2.10:4321", map[string]string{
+			DefaultSecretHeader: "s3cret",
+			timestampHeader:     strconv.FormatInt(now.Add(-time.Minute).Unix(), 10),
+		}, nil},
+		{"valid future", "192.0.2.10:4321", map[string]string{
+			DefaultSecretHeader: "s3cret",
+			timestampHeader:     strconv.FormatInt(now.Add(time.Minute).Unix(), 10),
+		}, nil},
+		{"IP not allowed", "198.51.100.1:4321", map[string]string{DefaultSecretHeader: "s3cret"}, ErrIPNotAllowed},
+		{"no secret", "192.0.2.10:4321", nil, ErrInvalidSecret},
+		{"wrong secret", "192.0.2.10:4321", map[string]string{DefaultSecretHeader: "s3cre"}, ErrInvalidSecret},
+		{"no timestamp", "192.0.2.10:4321", map[string]string{DefaultSecretHeader: "s3cret"}, ErrInvalidTimestamp},
+		{"invalid timestamp", "192.0.2.10:4321", map[string]string{
+			DefaultSecretHeader: "s3cret",
+			timestampHeader:     "yesterday",
+		}, ErrInvalidTimestamp},
+		{"stale", "192.0.2.10:4321", map[string]string{
+			DefaultSecretHeader: "s3cret",
+			timestampHeader:     strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10),
+		}, ErrStaleDelivery},
+		{"future", "192.0.2.10:4321", map[string]string{
+			DefaultSecretHeader: "s3cret",
+			timestampHeader:     strconv.FormatInt(now.Add(10*time.Minute).Unix(), 10),
+		}, ErrStaleDelivery},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for k, val := range tt.headers {
+				r.Header.Set(k, val)
+			}
+
+			err := v.Verify(r)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestVerifier_hooks(t *testing.T) {
+	v := &Verifier{
+		Secret: "s3cret",
+		Header: "Authorization",
+		MaxAge: time.Minute,
+		Timestamp: func(*http.Request) (time.Time, error) {
+			return time.Now(), nil
+		},
+		AllowIP: AllowPrefixes(netip.MustParsePrefix("2001:db8::/32"), netip.MustParsePrefix("203.0.113.7/32")),
+		ClientIP: func(r *http.Request) (netip.Addr, error) {
+			return netip.ParseAddr(strings.TrimSpace(strings.Split(r.Header.Get("X-Forwarded-For"), ",")[0]))
+		},
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.Header.Set("Authorization", "s3cret")
+	r.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.1")
+	require.NoError(t, v.Verify(r))
+
+	r.Header.Set("X-Forwarded-For", "2001:db8::1")
+	require.NoError(t, v.Verify(r))
+
+	r.Header.Set("X-Forwarded-For", "unknown")
+	require.ErrorIs(t, v.Verify(r), ErrIPNotAllowed)
+}
+
+func TestVerifier_Verify_noTimestamp(t *testing.T) {
+	v := &Verifier{MaxAge: time.Minute}
+
+	err := v.Verify(httptest.NewRequest(http.MethodPost, "/", nil))
+	require.ErrorIs(t, err, ErrInvalidTimestamp)
+	assert.EqualError(t, err, "webhook: invalid timestamp: MaxAge requires Timestamp")
+}
+
+func TestHandler_verifier(t *testing.T) {
+	h, events := recordEvents(t, &Options{Verifier: &Verifier{
+		Secret:  "s3cret",
+		AllowIP: AllowPrefixes(netip.MustParsePrefix("192.0.2.0/24")),
+	}})
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		secret     string
+		code       int
+	}{
+		{"valid", "192.0.2.1:1234", "s3cret", http.StatusOK},
+		{"forged", "192.0.2.1:1234", "guess", http.StatusUnauthorized},
+		{"IP not allowed", "203.0.113.1:1234", "s3cret", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"event": "translation.updated"}`))
+			r.RemoteAddr = tt.remoteAddr
+			r.Header.Set(DefaultSecretHeader, tt.secret)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+
+	assert.Len(t, *events, 1)
+}
+
+func TestVerifier_Middleware(t *testing.T) {
+	v := &Verifier{Secret: "s3cret"}
+	h := v.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	r.Header.Set(DefaultSecretHeader, "s3cret")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestWithSecret(t *testing.T) {
+	req := &model.WebhookAddRequest{Name: "hook", Headers: map[string]string{"X-Team": "l10n"}}
+
+	r, secret, err := WithSecret(req, "")
+	require.NoError(t, err)
+	assert.Len(t, secret, 64)
+	assert.Equal(t, map[string]string{"X-Team": "l10n", DefaultSecretHeader: secret}, r.Headers)
+	assert.Equal(t, map[string]string{"X-Team": "l10n"}, req.Headers, "request is modified")
+
+	r, other, err := WithSecret(&model.WebhookAddRequest{}, "X-Secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+	assert.Equal(t, map[string]string{"X-Secret": other}, r.Headers)
+
+	_, _, err = WithSecret(nil, "")
+	require.EqualError(t, err, "webhook: request cannot be nil")
+}
+
+func TestAddWithSecret(t *testing.T) {
+	var headers map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/projects/1/webhooks", r.URL.Path)
+		var req model.WebhookAddRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		headers = req.Headers
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": 4, "headers": req.Headers}}))
+	}))
+	defer server.Close()
+
+	client, err := crowdin.NewClient("token", crowdin.WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	hook, secret, err := AddWithSecret(context.Background(), client.Webhooks, 1, &model.WebhookAddRequest{
+		Name:        "hook",
+		URL:         "https://example.com/crowdin",
+		Events:      []model.Event{model.TranslationUpdated},
+		RequestType: "POST",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, hook.ID)
+	assert.Equal(t, secret, headers[DefaultSecretHeader])
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	for k, v := range hook.Headers {
+		r.Header.Set(k, v)
+	}
+	require.NoError(t, (&Verifier{Secret: secret}).Verify(r))
+}
+
+func TestAddWithSecret_nilRequest(t *testing.T) {
+	client, err := crowdin.NewClient("token")
+	require.NoError(t, err)
+
+	_, _, err = AddWithSecret(context.Background(), client.Webhooks, 1, nil)
+	require.EqualError(t, err, "webhook: request cannot be nil")
+}
+
+func TestAddWithSecret_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error": {"code": 403, "message": "Forbidden"}}`))
+	}))
+	defer server.Close()
+
+	client, err := crowdin.NewClient("token", crowdin.WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	_, secret, err := AddWithSecret(context.Background(), client.Webhooks, 1, &model.WebhookAddRequest{
+		Name: "hook", URL: "https://example.com", Events: []model.Event{model.FileAdded}, RequestType: "POST",
+	})
+	require.ErrorIs(t, err, crowdin.ErrForbidden)
+	assert.Empty(t, secret)
+}
@@ -0,0 +1,295 @@
+// Package webhook receives the events delivered by the Crowdin webhooks.
+//
+// A Handler is an http.Handler decoding the deliveries into typed events,
+// e.g. *FileTranslationEvent for "file.translated", and dispatching them to
+// the functions registered for their names:
+//
+//	h, err := webhook.NewHandler(nil)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	webhook.Handle(h, func(ctx context.Context, e *webhook.FileTranslationEvent) error {
+//		log.Printf("%s translated to %s", e.File.Path, e.TargetLanguage.ID)
+//		return nil
+//	}, model.FileTranslated)
+//	webhook.Handle(h, func(ctx context.Context, e *webhook.SuggestionEvent) error {
+//		// all the suggestion.* events
+//		return nil
+//	})
+//
+//	http.Handle("/crowdin", h)
+//
+// The deliveries of the webhooks with batching enabled are split into their
+// events, dispatched in order until a function fails. A function registered
+// with OnBatch receives instead all the events of each delivery at once.
+//
+// The deliveries are decoded according to the request type and the content
+// type of the webhook: JSON, form values and multipart forms in bracket
+// notation, e.g. "file[project][id]=1", and the query parameters of the GET
+// requests. The payloads rendered from the custom templates of the webhook,
+// given in Options.Payload, are converted to the same typed events.
+//
+// Deliveries can be authenticated with a secret shared through the headers
+// of the webhook, see Verifier and AddWithSecret.
+//
+// The handler responds with 200 OK when the events are handled or have no
+// function registered, 400 Bad Request when the payload is invalid, and
+// 500 Internal Server Error when the function fails. Functions can choose
+// the status code by returning an error wrapped with Status.
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// DefaultMaxBodySize is the default maximum size of the deliveries.
+const DefaultMaxBodySize = 10 << 20
+
+// ErrNoEvent is returned when a payload has no event name.
+var ErrNoEvent = errors.New("webhook: payload has no event")
+
+// HandlerFunc handles an event.
+type HandlerFunc func(ctx context.Context, e Event) error
+
+// BatchFunc handles the events of a delivery.
+type BatchFunc func(ctx context.Context, events []Event) error
+
+// Options specifies the optional parameters of a Handler.
+type Options struct {
+	// MaxBodySize is the maximum size of the deliveries in bytes.
+	// Larger deliveries are rejected with 413 Request Entity Too Large.
+	// Defaults to DefaultMaxBodySize.
+	MaxBodySize int64
+	// Fallback handles the events no function is registered for.
+	// If nil, they are acknowledged and ignored.
+	Fallback HandlerFunc
+	// ErrorLog is called with the errors of the deliveries
+	// rejected by the handler, if not nil.
+	ErrorLog func(r *http.Request, err error)
+	// Verifier verifies the authenticity of the deliveries before they are
+	// decoded. The deliveries failing the verification are rejected with
+	// 401 Unauthorized, or 403 Forbidden for the IP addresses not allowed.
+	Verifier *Verifier
+	// Payload holds the custom payload templates of the webhook, as in
+	// model.WebhookAddRequest.Payload: an object keyed by event names, e.g.
+	//
+	//	map[string]any{"file.translated": map[string]any{
+	//		"fileId":   "{{fileId}}",
+	//		"language": "{{targetLanguageId}}",
+	//	}}
+	//
+	// The variables of the templates fill the fields of the typed events.
+	// A variable names either the JSON path of a field of the default payload,
+	// e.g. "{{file.project.id}}", or its camel case ending, e.g. "{{projectId}}".
+	//
+	// The payloads are matched to their event by an "event": "{{event}}" key,
+	// or else by the shape of the templates. The templates without this key
+	// must not match the payloads of each other, NewHandler fails otherwise.
+	Payload any
+}
+
+// Handler is an http.Handler receiving the webhook deliveries.
+// The functions can be registered concurrently with the deliveries.
+type Handler struct {
+	opts Options
+
+	templates map[model.Event]any
+
+	mu       sync.RWMutex
+	handlers map[model.Event]HandlerFunc
+	batch    BatchFunc
+}
+
+// NewHandler returns a handler with no function registered.
+// If opts is nil, the default options are used. It returns an
+// error if the payload templates of the options are invalid.
+func NewHandler(opts *Options) (*Handler, error) {
+	h := &Handler{handlers: make(map[model.Event]HandlerFunc)}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.MaxBodySize <= 0 {
+		h.opts.MaxBodySize = DefaultMaxBodySize
+	}
+
+	templates, err := parseTemplates(h.opts.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: invalid payload templates: %w", err)
+	}
+	h.templates = templates
+	return h, nil
+}
+
+// On registers the function handling the events,
+// replacing the one previously registered.
+func (h *Handler) On(fn HandlerFunc, events ...model.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, e := range events {
+		h.handlers[e] = fn
+	}
+}
+
+// OnBatch registers the function handling all the events of each delivery in
+// one call, e.g. in a transaction. The deliveries of the webhooks with batching
+// disabled are passed as batches of one event. While a batch function is
+// registered, the per-event functions are not called. A nil function
+// unregisters it.
+func (h *Handler) OnBatch(fn BatchFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.batch = fn
+}
+
+// Handle registers the function handling the events of type E. If no event
+// name is given, the function handles all the events of type E, e.g. all the
+// suggestion.* events for *SuggestionEvent. It panics if an event is not of
+// type E.
+func Handle[E Event](h *Handler, fn func(ctx context.Context, e E) error, events ...model.Event) {
+	if len(events) == 0 {
+		for _, name := range eventNames() {
+			if _, ok := newEvent(name).(E); ok {
+				events = append(events, name)
+			}
+		}
+	}
+	for _, name := range events {
+		if _, ok := newEvent(name).(E); !ok {
+			panic(fmt.Sprintf("webhook: %s is not a %T", name, *new(E)))
+		}
+	}
+
+	h.On(func(ctx context.Context, e Event) error {
+		typed, ok := e.(E)
+		if !ok {
+			return fmt.Errorf("webhook: unexpected event type %T", e)
+		}
+		return fn(ctx, typed)
+	}, events...)
+}
+
+// ServeHTTP decodes the delivery and dispatches its event.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost && r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET, POST")
+		h.fail(w, r, http.StatusMethodNotAllowed, fmt.Errorf("webhook: method %s not allowed", r.Method))
+		return
+	}
+
+	if h.opts.Verifier != nil {
+		if err := h.opts.Verifier.Verify(r); err != nil {
+			h.fail(w, r, verifyStatus(err), err)
+			return
+		}
+	}
+
+	data, err := h.readPayload(w, r)
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		switch {
+		case errors.As(err, &maxErr):
+			h.fail(w, r, http.StatusRequestEntityTooLarge, err)
+		case errors.Is(err, errUnsupportedMediaType):
+			h.fail(w, r, http.StatusUnsupportedMediaType, err)
+		default:
+			h.fail(w, r, http.StatusBadRequest, err)
+		}
+		return
+	}
+
+	if len(h.templates) > 0 {
+		if data, err = h.applyTemplates(data); err != nil {
+			h.fail(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	events, err := ParseEvents(data)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.dispatchAll(r.Context(), events); err != nil {
+		code := http.StatusInternalServerError
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) {
+			code = statusErr.Code
+		}
+		h.fail(w, r, code, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Dispatch calls the function registered for the event,
+// or the fallback if none is registered.
+func (h *Handler) Dispatch(ctx context.Context, e Event) error {
+	h.mu.RLock()
+	fn, ok := h.handlers[e.EventName()]
+	h.mu.RUnlock()
+	if !ok {
+		fn = h.opts.Fallback
+	}
+	if fn == nil {
+		return nil
+	}
+	return fn(ctx, e)
+}
+
+// dispatchAll passes the events to the batch function if one is registered,
+// otherwise dispatches them in order until a function fails.
+func (h *Handler) dispatchAll(ctx context.Context, events []Event) error {
+	h.mu.RLock()
+	batch := h.batch
+	h.mu.RUnlock()
+	if batch != nil {
+		return batch(ctx, events)
+	}
+
+	for _, e := range events {
+		if err := h.Dispatch(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, code int, err error) {
+	if h.opts.ErrorLog != nil {
+		h.opts.ErrorLog(r, err)
+	}
+	http.Error(w, http.StatusText(code), code)
+}
+
+// StatusError is an error of a handler function
+// with the status code of the response.
+type StatusError struct {
+	Code int
+	Err  error
+}
+
+// Status wraps the error with the status code of the response,
+// e.g. http.StatusUnprocessableEntity.
+func Status(code int, err error) error {
+	return &StatusError{Code: code, Err: err}
+}
+
+// Error returns the message of the error.
+func (e *StatusError) Error() string {
+	if e.Err == nil {
+		return http.StatusText(e.Code)
+	}
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,309 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+const fileTranslatedPayload = `{
+	"event": "file.translated",
+	"file": {
+		"id": "44",
+		"name": "umbrella_app.xliff",
+		"title": "source_app_info",
+		"type": "xliff",
+		"path": "/directory1/directory2/umbrella_app.xliff",
+		"status": "active",
+		"revision": "10",
+		"branchId": null,
+		"directoryId": "4",
+		"project": {"id": "1", "identifier": "umbrella", "name": "Umbrella", "isExternal": false, "targetLanguageIds": ["uk"]}
+	},
+	"targetLanguage": {"id": "uk", "name": "Ukrainian", "twoLettersCode": "uk", "locale": "uk-UA", "dialectOf": null}
+}`
+
+func deliver(h http.Handler, method, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, "/crowdin", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestParse(t *testing.T) {
+	e, err := Parse([]byte(fileTranslatedPayload))
+	require.NoError(t, err)
+
+	event, ok := e.(*FileTranslationEvent)
+	require.True(t, ok)
+	assert.Equal(t, model.FileTranslated, event.EventName())
+	assert.Equal(t, Int(44), event.File.ID)
+	assert.Equal(t, Int(10), event.File.Revision)
+	assert.Equal(t, Int(0), event.File.BranchID)
+	assert.Equal(t, "/directory1/directory2/umbrella_app.xliff", event.File.Path)
+	assert.Equal(t, Int(1), event.File.Project.ID)
+	assert.Equal(t, "uk-UA", event.TargetLanguage.Locale)
+}
+
+func TestParse_events(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    Event
+	}{
+		{
+			`{"event": "file.added", "file": {"id": 1}, "user": {"id": "2", "username": "john"}}`,
+			&FileEvent{Base: Base{model.FileAdded}, File: &File{ID: 1}, User: &User{ID: 2, Username: "john"}},
+		},
+		{
+			`{"event": "project.approved", "project": {"id": "1"}, "targetLanguage": {"id": "fr"}}`,
+			&ProjectTranslationEvent{Base: Base{model.ProjectApproved}, Project: &Project{ID: 1}, TargetLanguage: &model.Language{ID: "fr"}},
+		},
+		{
+			`{"event": "project.built", "build": {"id": "7", "downloadLink": "https://example.com/build.zip", "project": {"id": "1"}}}`,
+			&ProjectBuiltEvent{Base: Base{model.ProjectBuilt}, Build: &Build{ID: 7, DownloadLink: "https://example.com/build.zip", Project: &Project{ID: 1}}},
+		},
+		{
+			`{"event": "project.created", "project": {"id": "1", "name": "Umbrella"}, "user": {"id": "2"}}`,
+			&ProjectEvent{Base: Base{model.ProjectCreated}, Project: &Project{ID: 1, Name: "Umbrella"}, User: &User{ID: 2}},
+		},
+		{
+			`{"event": "translation.updated", "oldTranslation": {"id": "1", "text": "Old"}, "newTranslation": {"id": "2", "text": "New", "isPreTranslated": true}}`,
+			&TranslationUpdatedEvent{Base: Base{model.TranslationUpdated}, OldTranslation: &Translation{ID: 1, Text: "Old"}, NewTranslation: &Translation{ID: 2, Text: "New", IsPreTranslated: true}},
+		},
+		{
+			`{"event": "string.updated", "string": {"id": "3", "text": "Hello", "isHidden": "0", "labelIds": ["1", 2]}}`,
+			&StringEvent{Base: Base{model.StringUpdated}, String: &String{ID: 3, Text: "Hello", LabelIDs: []Int{1, 2}}},
+		},
+		{
+			`{"event": "stringComment.created", "comment": {"id": "4", "text": "Typo", "type": "issue", "string": {"id": "3"}}}`,
+			&StringCommentEvent{Base: Base{model.StringCommentCreated}, Comment: &Comment{ID: 4, Text: "Typo", Type: "issue", String: &String{ID: 3}}},
+		},
+		{
+			`{"event": "suggestion.approved", "translation": {"id": "5", "text": "Привіт", "targetLanguage": {"id": "uk"}}}`,
+			&SuggestionEvent{Base: Base{model.SuggestionApproved}, Translation: &Translation{ID: 5, Text: "Привіт", TargetLanguage: &model.Language{ID: "uk"}}},
+		},
+		{
+			`{"event": "task.statusChanged", "task": {"id": "6", "oldStatus": "todo", "newStatus": "done", "wordsCount": "12"}}`,
+			&TaskEvent{Base: Base{model.TaskStatusChanged}, Task: &Task{ID: 6, OldStatus: "todo", NewStatus: "done", WordsCount: 12}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.want.EventName()), func(t *testing.T) {
+			e, err := Parse([]byte(tt.payload))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, e)
+		})
+	}
+}
+
+func TestParse_unknownEvent(t *testing.T) {
+	payload := `{"event": "glossary.updated", "glossary": {"id": 1}}`
+
+	e, err := Parse([]byte(payload))
+	require.NoError(t, err)
+	assert.Equal(t, &UnknownEvent{Base: Base{"glossary.updated"}, Data: []byte(payload)}, e)
+}
+
+func TestParse_invalid(t *testing.T) {
+	_, err := Parse([]byte(`{"file": {}}`))
+	require.ErrorIs(t, err, ErrNoEvent)
+
+	_, err = Parse([]byte(`not json`))
+	require.ErrorContains(t, err, "webhook: invalid payload")
+
+	_, err = Parse([]byte(`{"event": "file.added", "file": {"id": "abc"}}`))
+	require.EqualError(t, err, "webhook: invalid file.added payload: webhook: invalid integer \"abc\"")
+}
+
+func TestHandler(t *testing.T) {
+	h, err := NewHandler(nil)
+	require.NoError(t, err)
+
+	var got []string
+	Handle(h, func(_ context.Context, e *FileTranslationEvent) error {
+		got = append(got, string(e.Event)+" "+e.File.Name)
+		return nil
+	}, model.FileTranslated)
+	Handle(h, func(_ context.Context, e *SuggestionEvent) error {
+		got = append(got, string(e.Event))
+		return nil
+	})
+
+	w := deliver(h, http.MethodPost, fileTranslatedPayload)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = deliver(h, http.MethodPost, `{"event": "suggestion.disapproved", "translation": {"id": "1"}}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// No function is registered for the event.
+	w = deliver(h, http.MethodPost, `{"event": "file.approved", "file": {"id": "1"}}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	assert.Equal(t, []string{"file.translated umbrella_app.xliff", "suggestion.disapproved"}, got)
+}
+
+func TestHandler_errors(t *testing.T) {
+	var logged []error
+	h, err := NewHandler(&Options{
+		MaxBodySize: 1024,
+		ErrorLog:    func(_ *http.Request, err error) { logged = append(logged, err) },
+	})
+	require.NoError(t, err)
+	Handle(h, func(context.Context, *FileTranslationEvent) error {
+		return errors.New("database is down")
+	}, model.FileTranslated)
+	Handle(h, func(context.Context, *StringEvent) error {
+		return Status(http.StatusUnprocessableEntity, errors.New("unknown string"))
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		code   int
+	}{
+		{"method", http.MethodPut, fileTranslatedPayload, http.StatusMethodNotAllowed},
+		{"invalid", http.MethodPost, `{"event": 1}`, http.StatusBadRequest},
+		{"no event", http.MethodPost, `{}`, http.StatusBadRequest},
+		{"too large", http.MethodPost, `{"event": "file.added", "padding": "` + strings.Repeat("x", 1024) + `"}`, http.StatusRequestEntityTooLarge},
+		{"handler", http.MethodPost, fileTranslatedPayload, http.StatusInternalServerError},
+		{"status", http.MethodPost, `{"event": "string.added"}`, http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := deliver(h, tt.method, tt.body)
+			assert.Equal(t, tt.code, w.Code)
+			assert.Equal(t, http.StatusText(tt.code)+"\n", w.Body.String())
+		})
+	}
+
+	require.Len(t, logged, len(tests))
+	assert.EqualError(t, logged[4], "database is down")
+}
+
+func TestHandler_fallback(t *testing.T) {
+	var got Event
+	h, err := NewHandler(&Options{Fallback: func(_ context.Context, e Event) error {
+		got = e
+		return nil
+	}})
+	require.NoError(t, err)
+
+	w := deliver(h, http.MethodPost, `{"event": "glossary.updated"}`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, model.Event("glossary.updated"), got.EventName())
+}
+
+func TestHandle_panics(t *testing.T) {
+	h, err := NewHandler(nil)
+	require.NoError(t, err)
+	assert.PanicsWithValue(t, "webhook: file.added is not a *webhook.TaskEvent", func() {
+		Handle(h, func(context.Context, *TaskEvent) error { return nil }, model.FileAdded)
+	})
+}
+
+const batchPayload = `{"events": [
+	{"event": "string.added", "string": {"id": "1"}},
+	{"event": "suggestion.added", "translation": {"id": "2"}},
+	{"event": "glossary.updated"},
+	{"event": "string.deleted", "string": {"id": "3"}}
+]}`
+
+func TestParseEvents(t *testing.T) {
+	events, err := ParseEvents([]byte(batchPayload))
+	require.NoError(t, err)
+
+	require.Len(t, events, 4)
+	assert.Equal(t, &StringEvent{Base: Base{model.StringAdded}, String: &String{ID: 1}}, events[0])
+	assert.Equal(t, &SuggestionEvent{Base: Base{model.SuggestionAdded}, Translation: &Translation{ID: 2}}, events[1])
+	assert.Equal(t, &UnknownEvent{Base: Base{"glossary.updated"}, Data: []byte(`{"event": "glossary.updated"}`)}, events[2])
+	assert.Equal(t, &StringEvent{Base: Base{model.StringDeleted}, String: &String{ID: 3}}, events[3])
+
+	events, err = ParseEvents([]byte(fileTranslatedPayload))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, model.FileTranslated, events[0].EventName())
+
+	events, err = ParseEvents([]byte(`{"events": []}`))
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestParseEvents_invalid(t *testing.T) {
+	_, err := ParseEvents([]byte(`{"events": [{"event": "string.added"}, {"string": {}}]}`))
+	require.ErrorIs(t, err, ErrNoEvent)
+	assert.EqualError(t, err, "webhook: payload has no event (events[1])")
+
+	_, err = ParseEvents([]byte(`{"events": {}}`))
+	assert.ErrorContains(t, err, "webhook: invalid payload")
+}
+
+func TestHandler_batch(t *testing.T) {
+	h, err := NewHandler(nil)
+	require.NoError(t, err)
+
+	var got []string
+	Handle(h, func(_ context.Context, e *StringEvent) error {
+		got = append(got, fmt.Sprintf("%s %d", e.Event, e.String.ID))
+		if e.String.ID == 3 {
+			return errors.New("cannot delete")
+		}
+		return nil
+	})
+	Handle(h, func(_ context.Context, e *SuggestionEvent) error {
+		got = append(got, fmt.Sprintf("%s %d", e.Event, e.Translation.ID))
+		return nil
+	})
+
+	w := deliver(h, http.MethodPost, batchPayload)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, []string{"string.added 1", "suggestion.added 2", "string.deleted 3"}, got)
+
+	got = nil
+	w = deliver(h, http.MethodPost, `{"events": [{"event": "suggestion.added", "translation": {"id": "4"}}, {"event": "string.deleted", "string": {"id": "3"}}, {"event": "string.added", "string": {"id": "5"}}]}`)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, []string{"suggestion.added 4", "string.deleted 3"}, got, "the events after the failure are not dispatched")
+}
+
+func TestHandler_OnBatch(t *testing.T) {
+	h, err := NewHandler(nil)
+	require.NoError(t, err)
+	Handle(h, func(context.Context, *StringEvent) error {
+		t.Error("per-event function is called")
+		return nil
+	})
+
+	var batches [][]model.Event
+	h.OnBatch(func(_ context.Context, events []Event) error {
+		var names []model.Event
+		for _, e := range events {
+			names = append(names, e.EventName())
+		}
+		batches = append(batches, names)
+		return nil
+	})
+
+	assert.Equal(t, http.StatusOK, deliver(h, http.MethodPost, batchPayload).Code)
+	assert.Equal(t, http.StatusOK, deliver(h, http.MethodPost, `{"event": "string.updated"}`).Code)
+	assert.Equal(t, [][]model.Event{
+		{model.StringAdded, model.SuggestionAdded, "glossary.updated", model.StringDeleted},
+		{model.StringUpdated},
+	}, batches)
+
+	h.OnBatch(func(context.Context, []Event) error {
+		return Status(http.StatusConflict, errors.New("transaction aborted"))
+	})
+	assert.Equal(t, http.StatusConflict, deliver(h, http.MethodPost, batchPayload).Code)
+}
@@ -36,11 +36,12 @@ type WebhooksService struct {
 // List returns a list of webhooks for a project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.getMany
-func (s *WebhooksService) List(ctx context.Context, projectID int, opts *model.ListOptions) (
+func (s *WebhooksService) List(ctx context.Context, projectID int, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.Webhook, *Response, error,
 ) {
+	ctx = withOperation(ctx, "WebhooksService.List")
 	res := new(model.WebhooksListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks", projectID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks", projectID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -56,9 +57,10 @@ func (s *WebhooksService) List(ctx context.Context, projectID int, opts *model.L
 // Get returns a specific webhook for a project.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.get
-func (s *WebhooksService) Get(ctx context.Context, projectID, webhookID int) (*model.Webhook, *Response, error) {
+func (s *WebhooksService) Get(ctx context.Context, projectID, webhookID int, reqOpts ...RequestOption) (*model.Webhook, *Response, error) {
+	ctx = withOperation(ctx, "WebhooksService.Get")
 	res := new(model.WebhookResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -66,11 +68,12 @@ func (s *WebhooksService) Get(ctx context.Context, projectID, webhookID int) (*m
 // Add creates a new webhook.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.post
-func (s *WebhooksService) Add(ctx context.Context, projectID int, req *model.WebhookAddRequest) (
+func (s *WebhooksService) Add(ctx context.Context, projectID int, req *model.WebhookAddRequest, reqOpts ...RequestOption) (
 	*model.Webhook, *Response, error,
 ) {
+	ctx = withOperation(ctx, "WebhooksService.Add")
 	res := new(model.WebhookResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks", projectID), req, res)
+	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks", projectID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -85,11 +88,12 @@ func (s *WebhooksService) Add(ctx context.Context, projectID int, req *model.Web
 //   - Value (any): new value to set.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.patch
-func (s *WebhooksService) Edit(ctx context.Context, projectID, webhookID int, req []*model.UpdateRequest) (
+func (s *WebhooksService) Edit(ctx context.Context, projectID, webhookID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Webhook, *Response, error,
 ) {
+	ctx = withOperation(ctx, "WebhooksService.Edit")
 	res := new(model.WebhookResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -97,6 +101,7 @@ func (s *WebhooksService) Edit(ctx context.Context, projectID, webhookID int, re
 // Delete removes a specific webhook.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.projects.webhooks.delete
-func (s *WebhooksService) Delete(ctx context.Context, projectID, webhookID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID), nil)
+func (s *WebhooksService) Delete(ctx context.Context, projectID, webhookID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "WebhooksService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks/%d", projectID, webhookID), nil, reqOpts...)
 }
@@ -25,11 +25,12 @@ type OrganizationWebhooksService struct {
 // List returns a list of webhooks for an organization.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.webhooks.getMany
-func (s *OrganizationWebhooksService) List(ctx context.Context, opts *model.ListOptions) (
+func (s *OrganizationWebhooksService) List(ctx context.Context, opts *model.ListOptions, reqOpts ...RequestOption) (
 	[]*model.Webhook, *Response, error,
 ) {
+	ctx = withOperation(ctx, "OrganizationWebhooksService.List")
 	res := new(model.WebhooksListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/webhooks", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/webhooks", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -45,21 +46,23 @@ func (s *OrganizationWebhooksService) List(ctx context.Context, opts *model.List
 // Get returns a specific webhook for an organization.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.webhooks.get
-func (s *OrganizationWebhooksService) Get(ctx context.Context, organizationWebhookID int) (*model.Webhook, *Response, error) {
+func (s *OrganizationWebhooksService) Get(ctx context.Context, organizationWebhookID int, reqOpts ...RequestOption) (*model.Webhook, *Response, error) {
+	ctx = withOperation(ctx, "OrganizationWebhooksService.Get")
 	res := new(model.WebhookResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/webhooks/%d", organizationWebhookID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/webhooks/%d", organizationWebhookID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
 
-// Add creates a new webhook.
+// Add creates a new webhook for an organization.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.webhooks.post
-func (s *OrganizationWebhooksService) Add(ctx context.Context, projectID int, req *model.WebhookAddRequest) (
+func (s *OrganizationWebhooksService) Add(ctx context.Context, req *model.WebhookAddRequest, reqOpts ...RequestOption) (
 	*model.Webhook, *Response, error,
 ) {
+	ctx = withOperation(ctx, "OrganizationWebhooksService.Add")
 	res := new(model.WebhookResponse)
-	resp, err := s.client.Post(ctx, fmt.Sprintf("/api/v2/projects/%d/webhooks", projectID), req, res)
+	resp, err := s.client.Post(ctx, "/api/v2/webhooks", req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -74,11 +77,12 @@ func (s *OrganizationWebhooksService) Add(ctx context.Context, projectID int, re
 //   - Value (any): new value to set.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.webhooks.patch
-func (s *OrganizationWebhooksService) Edit(ctx context.Context, organizationWebhookID int, req []*model.UpdateRequest) (
+func (s *OrganizationWebhooksService) Edit(ctx context.Context, organizationWebhookID int, req []*model.UpdateRequest, reqOpts ...RequestOption) (
 	*model.Webhook, *Response, error,
 ) {
+	ctx = withOperation(ctx, "OrganizationWebhooksService.Edit")
 	res := new(model.WebhookResponse)
-	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/webhooks/%d", organizationWebhookID), req, res)
+	resp, err := s.client.Patch(ctx, fmt.Sprintf("/api/v2/webhooks/%d", organizationWebhookID), req, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -86,6 +90,7 @@ func (s *OrganizationWebhooksService) Edit(ctx context.Context, organizationWebh
 // Delete removes a webhook.
 //
 // https://developer.crowdin.com/api/v2/#operation/api.webhooks.delete
-func (s *OrganizationWebhooksService) Delete(ctx context.Context, organizationWebhookID int) (*Response, error) {
-	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/webhooks/%d", organizationWebhookID), nil)
+func (s *OrganizationWebhooksService) Delete(ctx context.Context, organizationWebhookID int, reqOpts ...RequestOption) (*Response, error) {
+	ctx = withOperation(ctx, "OrganizationWebhooksService.Delete")
+	return s.client.Delete(ctx, fmt.Sprintf("/api/v2/webhooks/%d", organizationWebhookID), nil, reqOpts...)
 }
@@ -0,0 +1,450 @@
+// Package webhooksync reconciles the webhooks of Crowdin projects and of the
+// organization with a declarative configuration.
+//
+// A Reconciler compares the desired webhooks with the existing ones, matched
+// by name, and computes a plan of the minimal changes: create the missing
+// webhooks, edit the changed fields with JSON patch operations, and
+// optionally delete the webhooks not declared. The plan can be printed as a
+// dry-run and applied:
+//
+//	r := webhooksync.New(client, &webhooksync.Options{Prune: true})
+//
+//	plan, err := r.Plan(ctx, &webhooksync.Desired{
+//		Projects: map[int][]*webhooksync.Spec{
+//			12: {{
+//				Name:   "translations",
+//				URL:    "https://example.com/crowdin",
+//				Events: []model.Event{model.FileTranslated, model.FileApproved},
+//			}},
+//		},
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	plan.WriteTo(os.Stdout)
+//
+//	res, err := r.Apply(ctx, plan)
+//	for _, e := range res.Errors {
+//		log.Printf("%s %s: %v", e.Change.Action, e.Change.Name, e.Err)
+//	}
+package webhooksync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// Action is an action of a plan.
+type Action string
+
+// Actions of a plan.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionSkip   Action = "skip"
+)
+
+// Spec is the desired state of a webhook. The webhooks are identified by
+// their name in the project or in the organization.
+type Spec struct {
+	// Name is the name of the webhook.
+	Name string
+	// URL is the URL the events are delivered to.
+	URL string
+	// Events lists the events delivered. Their order does not matter.
+	Events []model.Event
+	// Headers are the headers sent with the deliveries, e.g. a secret.
+	Headers map[string]string
+	// ContentType is the content type of the deliveries.
+	// Defaults to model.ContentTypeJSON.
+	ContentType model.WebhookContentType
+	// RequestType is the method of the deliveries, GET or POST.
+	// Defaults to POST.
+	RequestType string
+	// BatchingEnabled enables the delivery of several events at once.
+	BatchingEnabled bool
+	// Inactive disables the webhook.
+	Inactive bool
+	// Payload is the custom payload of the webhook, see
+	// model.WebhookAddRequest. If nil, the payload is not managed.
+	Payload any
+}
+
+// Desired is the desired set of webhooks.
+type Desired struct {
+	// Projects maps the identifiers of the projects to their webhooks.
+	// The projects not listed are left unchanged.
+	Projects map[int][]*Spec
+	// Organization lists the webhooks of the organization.
+	// If nil, the webhooks of the organization are left unchanged.
+	Organization []*Spec
+}
+
+// Options specifies the optional parameters of a Reconciler.
+type Options struct {
+	// Prune enables the deletion of the existing webhooks that are
+	// not declared, in the projects listed and in the organization,
+	// and of the duplicates of the declared ones.
+	Prune bool
+	// Progress is called after each change is applied,
+	// with the error of the change if any.
+	Progress func(change *Change, err error)
+}
+
+// Change is a change of a plan.
+type Change struct {
+	Action Action
+	// ProjectID is the identifier of the project of the webhook,
+	// or zero for a webhook of the organization.
+	ProjectID int
+	// Name is the name of the webhook.
+	Name string
+	// WebhookID is the identifier of the existing webhook,
+	// or zero for a webhook to create.
+	WebhookID int
+	// Add is the request creating the webhook.
+	Add *model.WebhookAddRequest
+	// Patch lists the operations editing the webhook.
+	Patch []*model.UpdateRequest
+	// Duplicate reports whether another webhook with the same name
+	// is reconciled instead. The duplicates are deleted with Prune,
+	// and skipped otherwise.
+	Duplicate bool
+}
+
+// String returns the target of the change, e.g. `project 12 "translations"`.
+func (c *Change) String() string {
+	if c.ProjectID == 0 {
+		return fmt.Sprintf("organization %q", c.Name)
+	}
+	return fmt.Sprintf("project %d %q", c.ProjectID, c.Name)
+}
+
+// Plan lists the changes reconciling the webhooks, by project
+// with the organization last, and by name.
+type Plan struct {
+	Changes []*Change
+}
+
+// Count returns the number of changes with the action.
+func (p *Plan) Count(action Action) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteTo writes the changes of the plan, one per line, e.g.
+//
+//	update project 12 "translations" (url, events)
+//	skip   project 12 "strings" (duplicate)
+//
+// It implements the io.WriterTo interface.
+func (p *Plan) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	for _, c := range p.Changes {
+		line := c.String()
+		if len(c.Patch) > 0 {
+			fields := make([]string, 0, len(c.Patch))
+			for _, op := range c.Patch {
+				fields = append(fields, strings.TrimPrefix(op.Path, "/"))
+			}
+			line += " (" + strings.Join(fields, ", ") + ")"
+		}
+		if c.Duplicate {
+			line += " (duplicate)"
+		}
+		n, err := fmt.Fprintf(w, "%-6s %s\n", c.Action, line)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ChangeError is the error of a change that could not be applied.
+type ChangeError struct {
+	Change *Change
+	Err    error
+}
+
+// Error implements the Error interface.
+func (e *ChangeError) Error() string {
+	return fmt.Sprintf("webhooksync: cannot %s %s: %v", e.Change.Action, e.Change, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ChangeError) Unwrap() error {
+	return e.Err
+}
+
+// Result is the result of applying a plan.
+type Result struct {
+	// Applied lists the changes applied successfully, skipped changes included.
+	Applied []*Change
+	// Errors lists the changes that could not be applied.
+	Errors []*ChangeError
+}
+
+// Reconciler reconciles the webhooks with the desired ones.
+type Reconciler struct {
+	client *crowdin.Client
+	opts   Options
+}
+
+// New returns a reconciler of the webhooks. If opts is nil,
+// the default options are used.
+func New(client *crowdin.Client, opts *Options) *Reconciler {
+	r := &Reconciler{client: client}
+	if opts != nil {
+		r.opts = *opts
+	}
+	return r
+}
+
+// Plan lists the existing webhooks of the projects and of the organization,
+// and computes the changes reconciling them with the desired ones.
+// A nil desired state manages no webhooks, so the plan is empty.
+func (r *Reconciler) Plan(ctx context.Context, desired *Desired) (*Plan, error) {
+	plan := &Plan{}
+	if desired == nil {
+		return plan, nil
+	}
+
+	projectIDs := slices.Sorted(maps.Keys(desired.Projects))
+	for _, projectID := range projectIDs {
+		var existing []*model.Webhook
+		for hook, err := range r.client.Webhooks.ListAll(ctx, projectID, nil) {
+			if err != nil {
+				return nil, fmt.Errorf("webhooksync: cannot list webhooks of project %d: %w", projectID, err)
+			}
+			existing = append(existing, hook)
+		}
+		changes, err := r.diff(projectID, desired.Projects[projectID], existing)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+
+	if desired.Organization != nil {
+		var existing []*model.Webhook
+		for hook, err := range r.client.OrganizationWebhooks.ListAll(ctx, nil) {
+			if err != nil {
+				return nil, fmt.Errorf("webhooksync: cannot list webhooks of the organization: %w", err)
+			}
+			existing = append(existing, hook)
+		}
+		changes, err := r.diff(0, desired.Organization, existing)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, changes...)
+	}
+	return plan, nil
+}
+
+// diff computes the changes reconciling the existing webhooks of the project,
+// or of the organization if projectID is zero, with the specs. The duplicates
+// of an existing webhook, with the same name, are deleted with Prune and
+// skipped otherwise.
+func (r *Reconciler) diff(projectID int, specs []*Spec, existing []*model.Webhook) ([]*Change, error) {
+	byName := make(map[string]*Spec, len(specs))
+	for _, spec := range specs {
+		if spec.Name == "" {
+			return nil, errors.New("webhooksync: webhook name is required")
+		}
+		if _, ok := byName[spec.Name]; ok {
+			return nil, fmt.Errorf("webhooksync: duplicate webhook %q", spec.Name)
+		}
+		byName[spec.Name] = spec
+	}
+
+	sort.SliceStable(existing, func(i, j int) bool { return existing[i].ID < existing[j].ID })
+
+	var changes []*Change
+	matched := make(map[string]bool, len(specs))
+	for _, hook := range existing {
+		spec, ok := byName[hook.Name]
+		if ok && matched[hook.Name] {
+			change := &Change{Action: ActionSkip, ProjectID: projectID, Name: hook.Name, WebhookID: hook.ID, Duplicate: true}
+			if r.opts.Prune {
+				change.Action = ActionDelete
+			}
+			changes = append(changes, change)
+			continue
+		}
+		if !ok {
+			if r.opts.Prune {
+				changes = append(changes, &Change{Action: ActionDelete, ProjectID: projectID, Name: hook.Name, WebhookID: hook.ID})
+			}
+			continue
+		}
+		matched[hook.Name] = true
+
+		change := &Change{Action: ActionSkip, ProjectID: projectID, Name: hook.Name, WebhookID: hook.ID}
+		if change.Patch = patch(spec, hook); len(change.Patch) > 0 {
+			change.Action = ActionUpdate
+		}
+		changes = append(changes, change)
+	}
+
+	for _, spec := range specs {
+		if !matched[spec.Name] {
+			changes = append(changes, &Change{Action: ActionCreate, ProjectID: projectID, Name: spec.Name, Add: spec.addRequest()})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes, nil
+}
+
+// addRequest returns the request creating the webhook.
+func (s *Spec) addRequest() *model.WebhookAddRequest {
+	return &model.WebhookAddRequest{
+		Name:            s.Name,
+		URL:             s.URL,
+		Events:          s.Events,
+		RequestType:     s.requestType(),
+		IsActive:        crowdin.ToPtr(!s.Inactive),
+		BatchingEnabled: crowdin.ToPtr(s.BatchingEnabled),
+		ContentType:     s.contentType(),
+		Headers:         s.Headers,
+		Payload:         s.Payload,
+	}
+}
+
+func (s *Spec) requestType() string {
+	if s.RequestType == "" {
+		return "POST"
+	}
+	return s.RequestType
+}
+
+func (s *Spec) contentType() model.WebhookContentType {
+	if s.ContentType == "" {
+		return model.ContentTypeJSON
+	}
+	return s.ContentType
+}
+
+// patch returns the operations replacing the fields
+// of the webhook that differ from the spec.
+func patch(spec *Spec, hook *model.Webhook) []*model.UpdateRequest {
+	var ops []*model.UpdateRequest
+	replace := func(path string, value any) {
+		ops = append(ops, &model.UpdateRequest{Op: model.OpReplace, Path: path, Value: value})
+	}
+
+	if spec.URL != hook.URL {
+		replace("/url", spec.URL)
+	}
+	if !sameEvents(spec.Events, hook.Events) {
+		replace("/events", spec.Events)
+	}
+	if !maps.Equal(spec.Headers, hook.Headers) {
+		headers := spec.Headers
+		if headers == nil {
+			headers = map[string]string{}
+		}
+		replace("/headers", headers)
+	}
+	if string(spec.contentType()) != hook.ContentType {
+		replace("/contentType", spec.contentType())
+	}
+	if spec.requestType() != hook.RequestType {
+		replace("/requestType", spec.requestType())
+	}
+	if spec.BatchingEnabled != hook.BatchingEnabled {
+		replace("/batchingEnabled", spec.BatchingEnabled)
+	}
+	if !spec.Inactive != hook.IsActive {
+		replace("/isActive", !spec.Inactive)
+	}
+	if spec.Payload != nil && !samePayload(spec.Payload, hook.Payload) {
+		replace("/payload", spec.Payload)
+	}
+	return ops
+}
+
+func sameEvents(want []model.Event, got []string) bool {
+	w := make([]string, 0, len(want))
+	for _, e := range want {
+		w = append(w, string(e))
+	}
+	slices.Sort(w)
+	g := slices.Sorted(slices.Values(got))
+	return slices.Equal(slices.Compact(w), slices.Compact(g))
+}
+
+// samePayload compares the payloads as JSON values.
+func samePayload(want any, got map[string]any) bool {
+	data, err := json.Marshal(want)
+	if err != nil {
+		return false
+	}
+	var w map[string]any
+	if err := json.Unmarshal(data, &w); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(w, got)
+}
+
+// Apply applies the changes of the plan in order. It returns the changes
+// applied and the errors of the other ones, joined in the returned error.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) (*Result, error) {
+	res := &Result{}
+	for _, c := range plan.Changes {
+		err := r.apply(ctx, c)
+		if err != nil {
+			res.Errors = append(res.Errors, &ChangeError{Change: c, Err: err})
+		} else {
+			res.Applied = append(res.Applied, c)
+		}
+		if r.opts.Progress != nil {
+			r.opts.Progress(c, err)
+		}
+	}
+
+	errs := make([]error, 0, len(res.Errors))
+	for _, e := range res.Errors {
+		errs = append(errs, e)
+	}
+	return res, errors.Join(errs...)
+}
+
+// apply applies the change.
+func (r *Reconciler) apply(ctx context.Context, c *Change) error {
+	var err error
+	switch {
+	case c.Action == ActionCreate && c.ProjectID == 0:
+		_, _, err = r.client.OrganizationWebhooks.Add(ctx, c.Add)
+	case c.Action == ActionCreate:
+		_, _, err = r.client.Webhooks.Add(ctx, c.ProjectID, c.Add)
+	case c.Action == ActionUpdate && c.ProjectID == 0:
+		_, _, err = r.client.OrganizationWebhooks.Edit(ctx, c.WebhookID, c.Patch)
+	case c.Action == ActionUpdate:
+		_, _, err = r.client.Webhooks.Edit(ctx, c.ProjectID, c.WebhookID, c.Patch)
+	case c.Action == ActionDelete && c.ProjectID == 0:
+		_, err = r.client.OrganizationWebhooks.Delete(ctx, c.WebhookID)
+	case c.Action == ActionDelete:
+		_, err = r.client.Webhooks.Delete(ctx, c.ProjectID, c.WebhookID)
+	}
+	return err
+}
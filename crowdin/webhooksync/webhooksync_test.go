@@ -0,0 +1,311 @@
+package webhooksync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/crowdin/crowdin-api-client-go/crowdin"
+	"github.com/crowdin/crowdin-api-client-go/crowdin/model"
+)
+
+// account is a fake Crowdin account serving the webhooks API
+// of the project 1 and of the organization.
+type account struct {
+	t *testing.T
+
+	mu       sync.Mutex
+	project  []*model.Webhook
+	org      []*model.Webhook
+	requests []string
+	fail     bool // whether the edits fail
+}
+
+func setup(t *testing.T, a *account) *crowdin.Client {
+	t.Helper()
+
+	a.t = t
+	server := httptest.NewServer(a)
+	t.Cleanup(server.Close)
+
+	client, err := crowdin.NewClient("token", crowdin.WithBaseURL(server.URL))
+	require.NoError(t, err)
+	return client
+}
+
+func (a *account) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	route := r.Method + " " + r.URL.Path
+	switch route {
+	case "GET /api/v2/projects/1/webhooks":
+		a.list(w, r, a.project)
+		return
+	case "GET /api/v2/webhooks":
+		a.list(w, r, a.org)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	require.NoError(a.t, err)
+	a.requests = append(a.requests, route+" "+string(bytes.TrimSpace(body)))
+
+	switch {
+	case r.Method == http.MethodPost:
+		writeData(w, &model.Webhook{ID: 100})
+	case r.Method == http.MethodPatch && a.fail:
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": {"code": 403, "message": "Forbidden"}}`)
+	case r.Method == http.MethodPatch:
+		writeData(w, &model.Webhook{ID: 1})
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		a.t.Errorf("unexpected request: %s", route)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (a *account) list(w http.ResponseWriter, r *http.Request, hooks []*model.Webhook) {
+	if offset := r.URL.Query().Get("offset"); offset != "" && offset != "0" {
+		fmt.Fprint(w, `{"data": []}`)
+		return
+	}
+	data := make([]map[string]any, 0, len(hooks))
+	for _, hook := range hooks {
+		data = append(data, map[string]any{"data": hook})
+	}
+	require.NoError(a.t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+}
+
+func writeData(w http.ResponseWriter, v any) {
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": v})
+}
+
+func newAccount() *account {
+	return &account{
+		project: []*model.Webhook{
+			{
+				ID: 1, Name: "translations", URL: "https://example.com/old",
+				Events:   []string{"file.approved", "file.translated"},
+				Headers:  map[string]string{"X-Secret": "s3cret"},
+				IsActive: true, RequestType: "POST", ContentType: "application/json",
+			},
+			{
+				ID: 2, Name: "strings", URL: "https://example.com/strings",
+				Events:   []string{"string.added"},
+				IsActive: true, RequestType: "POST", ContentType: "application/json",
+				Payload: map[string]any{"id": "{{stringId}}"},
+			},
+			{ID: 3, Name: "legacy", URL: "https://example.com/legacy", IsActive: true},
+			{ID: 4, Name: "strings", URL: "https://example.com/strings"},
+		},
+		org: []*model.Webhook{
+			{ID: 7, Name: "projects", URL: "https://example.com/projects", IsActive: true},
+		},
+	}
+}
+
+func desired() *Desired {
+	return &Desired{
+		Projects: map[int][]*Spec{
+			1: {
+				{
+					Name:            "translations",
+					URL:             "https://example.com/crowdin",
+					Events:          []model.Event{model.FileTranslated, model.FileApproved},
+					Headers:         map[string]string{"X-Secret": "s3cret"},
+					BatchingEnabled: true,
+				},
+				{
+					Name:    "strings",
+					URL:     "https://example.com/strings",
+					Events:  []model.Event{model.StringAdded},
+					Payload: map[string]string{"id": "{{stringId}}"},
+				},
+				{
+					Name:   "tasks",
+					URL:    "https://example.com/tasks",
+					Events: []model.Event{model.TaskAdded},
+				},
+			},
+		},
+		Organization: []*Spec{
+			{Name: "builds", URL: "https://example.com/builds", Events: []model.Event{"project.created"}},
+		},
+	}
+}
+
+func TestReconciler_Plan(t *testing.T) {
+	client := setup(t, newAccount())
+
+	plan, err := New(client, &Options{Prune: true}).Plan(context.Background(), desired())
+	require.NoError(t, err)
+
+	var out strings.Builder
+	_, err = plan.WriteTo(&out)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		`delete project 1 "legacy"`,
+		`skip   project 1 "strings"`,
+		`delete project 1 "strings" (duplicate)`,
+		`create project 1 "tasks"`,
+		`update project 1 "translations" (url, batchingEnabled)`,
+		`create organization "builds"`,
+		`delete organization "projects"`,
+	}, "\n")+"\n", out.String())
+
+	assert.Equal(t, 2, plan.Count(ActionCreate))
+	assert.Equal(t, 1, plan.Count(ActionUpdate))
+	assert.Equal(t, 3, plan.Count(ActionDelete))
+	assert.Equal(t, 1, plan.Count(ActionSkip))
+
+	assert.Equal(t, 4, plan.Changes[2].WebhookID, "duplicate with the highest ID is deleted")
+	assert.True(t, plan.Changes[2].Duplicate)
+	assert.Equal(t, &model.WebhookAddRequest{
+		Name:            "tasks",
+		URL:             "https://example.com/tasks",
+		Events:          []model.Event{model.TaskAdded},
+		RequestType:     "POST",
+		IsActive:        crowdin.ToPtr(true),
+		BatchingEnabled: crowdin.ToPtr(false),
+		ContentType:     model.ContentTypeJSON,
+	}, plan.Changes[3].Add)
+}
+
+func TestReconciler_Plan_noPrune(t *testing.T) {
+	client := setup(t, newAccount())
+
+	d := desired()
+	d.Organization = nil
+	plan, err := New(client, nil).Plan(context.Background(), d)
+	require.NoError(t, err)
+
+	assert.Zero(t, plan.Count(ActionDelete))
+	for _, c := range plan.Changes {
+		assert.NotZero(t, c.ProjectID, "organization webhooks are not managed")
+	}
+
+	var out strings.Builder
+	_, err = plan.WriteTo(&out)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		`skip   project 1 "strings"`,
+		`skip   project 1 "strings" (duplicate)`,
+		`create project 1 "tasks"`,
+		`update project 1 "translations" (url, batchingEnabled)`,
+	}, "\n")+"\n", out.String())
+}
+
+func TestReconciler_Plan_nil(t *testing.T) {
+	client := setup(t, newAccount())
+
+	plan, err := New(client, &Options{Prune: true}).Plan(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes)
+}
+
+func TestReconciler_Plan_invalid(t *testing.T) {
+	client := setup(t, newAccount())
+	r := New(client, nil)
+
+	_, err := r.Plan(context.Background(), &Desired{Projects: map[int][]*Spec{1: {{Name: "a"}, {Name: "a"}}}})
+	require.EqualError(t, err, `webhooksync: duplicate webhook "a"`)
+
+	_, err = r.Plan(context.Background(), &Desired{Projects: map[int][]*Spec{1: {{URL: "https://example.com"}}}})
+	require.EqualError(t, err, "webhooksync: webhook name is required")
+}
+
+func TestPatch(t *testing.T) {
+	hook := &model.Webhook{
+		URL:         "https://example.com",
+		Events:      []string{"file.added"},
+		Headers:     map[string]string{"X-Secret": "s3cret"},
+		IsActive:    true,
+		RequestType: "POST",
+		ContentType: "application/json",
+		Payload:     map[string]any{"n": float64(1)},
+	}
+
+	assert.Empty(t, patch(&Spec{
+		URL:     "https://example.com",
+		Events:  []model.Event{model.FileAdded, model.FileAdded},
+		Headers: map[string]string{"X-Secret": "s3cret"},
+		Payload: map[string]int{"n": 1},
+	}, hook))
+
+	assert.Equal(t, []*model.UpdateRequest{
+		{Op: model.OpReplace, Path: "/events", Value: []model.Event{model.FileAdded, model.FileDeleted}},
+		{Op: model.OpReplace, Path: "/headers", Value: map[string]string{}},
+		{Op: model.OpReplace, Path: "/contentType", Value: model.ContentTypeForm},
+		{Op: model.OpReplace, Path: "/requestType", Value: "GET"},
+		{Op: model.OpReplace, Path: "/isActive", Value: false},
+		{Op: model.OpReplace, Path: "/payload", Value: map[string]any{"n": 2}},
+	}, patch(&Spec{
+		URL:         "https://example.com",
+		Events:      []model.Event{model.FileAdded, model.FileDeleted},
+		ContentType: model.ContentTypeForm,
+		RequestType: "GET",
+		Inactive:    true,
+		Payload:     map[string]any{"n": 2},
+	}, hook))
+}
+
+func TestReconciler_Apply(t *testing.T) {
+	a := newAccount()
+	client := setup(t, a)
+
+	var progress []string
+	r := New(client, &Options{
+		Prune: true,
+		Progress: func(c *Change, err error) {
+			progress = append(progress, fmt.Sprintf("%s %s %t", c.Action, c, err == nil))
+		},
+	})
+
+	plan, err := r.Plan(context.Background(), desired())
+	require.NoError(t, err)
+
+	res, err := r.Apply(context.Background(), plan)
+	require.NoError(t, err)
+	assert.Len(t, res.Applied, 7)
+	assert.Empty(t, res.Errors)
+	assert.Len(t, progress, 7)
+	assert.Equal(t, `delete project 1 "legacy" true`, progress[0])
+
+	assert.Equal(t, []string{
+		`DELETE /api/v2/projects/1/webhooks/3 `,
+		`DELETE /api/v2/projects/1/webhooks/4 `,
+		`POST /api/v2/projects/1/webhooks {"name":"tasks","url":"https://example.com/tasks","events":["task.added"],"requestType":"POST","isActive":true,"batchingEnabled":false,"contentType":"application/json"}`,
+		`PATCH /api/v2/projects/1/webhooks/1 [{"op":"replace","path":"/url","value":"https://example.com/crowdin"},{"op":"replace","path":"/batchingEnabled","value":true}]`,
+		`POST /api/v2/webhooks {"name":"builds","url":"https://example.com/builds","events":["project.created"],"requestType":"POST","isActive":true,"batchingEnabled":false,"contentType":"application/json"}`,
+		`DELETE /api/v2/webhooks/7 `,
+	}, a.requests)
+}
+
+func TestReconciler_Apply_errors(t *testing.T) {
+	a := newAccount()
+	a.fail = true
+	client := setup(t, a)
+	r := New(client, nil)
+
+	plan, err := r.Plan(context.Background(), desired())
+	require.NoError(t, err)
+
+	res, err := r.Apply(context.Background(), plan)
+	require.ErrorIs(t, err, crowdin.ErrForbidden)
+	require.Len(t, res.Errors, 1)
+	assert.EqualError(t, res.Errors[0], `webhooksync: cannot update project 1 "translations": 403 Forbidden`)
+	assert.Len(t, res.Applied, len(plan.Changes)-1)
+}
@@ -22,9 +22,10 @@ type WorkflowsService struct {
 // ListSteps returns a list of workflow steps available in the project.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.workflow-steps.getMany
-func (s *WorkflowsService) ListSteps(ctx context.Context, projectID string) ([]*model.WorkflowStep, *Response, error) {
+func (s *WorkflowsService) ListSteps(ctx context.Context, projectID string, reqOpts ...RequestOption) ([]*model.WorkflowStep, *Response, error) {
+	ctx = withOperation(ctx, "WorkflowsService.ListSteps")
 	res := new(model.WorkflowStepsResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%s/workflow-steps", projectID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%s/workflow-steps", projectID), nil, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -40,11 +41,12 @@ func (s *WorkflowsService) ListSteps(ctx context.Context, projectID string) ([]*
 // ListStepStrings returns a list of strings on	the workflow step.
 //
 // https://support.crowdin.com/developer/enterprise/api/v2/#tag/Workflows/operation/api.projects.workflow-steps.strings.getMany
-func (s *WorkflowsService) ListStepStrings(ctx context.Context, projectID, stepID int, opts *model.WorkflowStepStringsListOptions) (
+func (s *WorkflowsService) ListStepStrings(ctx context.Context, projectID, stepID int, opts *model.WorkflowStepStringsListOptions, reqOpts ...RequestOption) (
 	[]*model.SourceString, *Response, error,
 ) {
+	ctx = withOperation(ctx, "WorkflowsService.ListStepStrings")
 	res := new(model.SourceStringsListResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/workflow-steps/%d/strings", projectID, stepID), opts, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/workflow-steps/%d/strings", projectID, stepID), opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -60,9 +62,10 @@ func (s *WorkflowsService) ListStepStrings(ctx context.Context, projectID, stepI
 // GetStep returns a specific workflow step.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.projects.workflow-steps.get
-func (s *WorkflowsService) GetStep(ctx context.Context, projectID, stepID int) (*model.WorkflowStep, *Response, error) {
+func (s *WorkflowsService) GetStep(ctx context.Context, projectID, stepID int, reqOpts ...RequestOption) (*model.WorkflowStep, *Response, error) {
+	ctx = withOperation(ctx, "WorkflowsService.GetStep")
 	res := new(model.WorkflowStepResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/workflow-steps/%d", projectID, stepID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/projects/%d/workflow-steps/%d", projectID, stepID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }
@@ -70,11 +73,12 @@ func (s *WorkflowsService) GetStep(ctx context.Context, projectID, stepID int) (
 // ListTemplates returns a list of workflow templates available in the organization.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.workflow-templates.get
-func (s *WorkflowsService) ListTemplates(ctx context.Context, opts *model.WorkflowTemplatesListOptions) (
+func (s *WorkflowsService) ListTemplates(ctx context.Context, opts *model.WorkflowTemplatesListOptions, reqOpts ...RequestOption) (
 	[]*model.WorkflowTemplate, *Response, error,
 ) {
+	ctx = withOperation(ctx, "WorkflowsService.ListTemplates")
 	res := new(model.WorkflowTemplatesListResponse)
-	resp, err := s.client.Get(ctx, "/api/v2/workflow-templates", opts, res)
+	resp, err := s.client.Get(ctx, "/api/v2/workflow-templates", opts, res, reqOpts...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -90,9 +94,10 @@ func (s *WorkflowsService) ListTemplates(ctx context.Context, opts *model.Workfl
 // GetTemplate returns a specific workflow template.
 //
 // https://developer.crowdin.com/enterprise/api/v2/#operation/api.workflow-templates.get
-func (s *WorkflowsService) GetTemplate(ctx context.Context, templateID int) (*model.WorkflowTemplate, *Response, error) {
+func (s *WorkflowsService) GetTemplate(ctx context.Context, templateID int, reqOpts ...RequestOption) (*model.WorkflowTemplate, *Response, error) {
+	ctx = withOperation(ctx, "WorkflowsService.GetTemplate")
 	res := new(model.WorkflowTemplateResponse)
-	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/workflow-templates/%d", templateID), nil, res)
+	resp, err := s.client.Get(ctx, fmt.Sprintf("/api/v2/workflow-templates/%d", templateID), nil, res, reqOpts...)
 
 	return res.Data, resp, err
 }